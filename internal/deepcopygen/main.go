@@ -0,0 +1,504 @@
+// Command deepcopygen generates the DeepCopy and DeepCopyInto methods of the
+// models of a package, i.e. its structs with nullFields and the structs they
+// reference, along with the accessors of their nullFields and forceSendFields
+// used by generic code, e.g. package diff. It is meant to be run by go
+// generate, from a directive in any file of the package:
+//
+//	//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/deepcopygen
+//
+// Run `make generate` after changing a model to keep its methods in sync.
+// Unlike a struct copy, which shares the backing arrays of the unexported
+// forceSendFields and nullFields, or a JSON round trip, which loses them,
+// generated copies hold the whole state of the models.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	jsonutilImportPath = "github.com/spotinst/spotinst-sdk-go/spotinst/util/jsonutil"
+	outputFilename     = "deepcopy.go"
+)
+
+// kind is the way values of a type are copied.
+type kind int
+
+const (
+	// kindValue types are copied by assignment, e.g. string or time.Time.
+	kindValue kind = iota
+
+	// kindStruct types are structs of the package, copied by DeepCopyInto.
+	kindStruct
+
+	// kindCopier types have a DeepCopy method, e.g. jsonutil.UnknownFields.
+	kindCopier
+
+	// kindPointer, kindSlice and kindMap types are copied according to the kind
+	// of their elements.
+	kindPointer
+	kindSlice
+	kindMap
+
+	// kindInterface types hold decoded JSON values, copied by
+	// jsonutil.DeepCopyValue.
+	kindInterface
+)
+
+// externalTypes holds the kinds of the supported types declared by other
+// packages, keyed by import path and name.
+var externalTypes = map[string]kind{
+	"time.Time":                           kindValue,
+	"encoding/json.RawMessage":            kindSlice,
+	jsonutilImportPath + ".UnknownFields": kindCopier,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("deepcopygen: ")
+
+	dir := flag.String("dir", ".", "directory of the package")
+	flag.Parse()
+
+	src, err := generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(*dir, outputFilename), src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// A generator writes the methods of the models of a package.
+type generator struct {
+	buf bytes.Buffer
+
+	pkg     string
+	types   map[string]*ast.TypeSpec
+	imports map[*ast.TypeSpec]map[string]string // imports of the declaring file
+	used    map[string]bool
+	err     error
+}
+
+// generate returns the source of the deep copy methods of the models of the
+// package in dir.
+func generate(dir string) ([]byte, error) {
+	g := &generator{
+		types:   make(map[string]*ast.TypeSpec),
+		imports: make(map[*ast.TypeSpec]map[string]string),
+		used:    make(map[string]bool),
+	}
+
+	if err := g.parse(dir); err != nil {
+		return nil, err
+	}
+
+	names := g.models()
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%s: no models found", dir)
+	}
+
+	var body bytes.Buffer
+	for _, name := range names {
+		g.writeMethods(name)
+	}
+	if g.err != nil {
+		return nil, g.err
+	}
+	body, g.buf = g.buf, body
+
+	g.printf("// Code generated by internal/deepcopygen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", g.pkg)
+	if len(g.used) > 0 {
+		paths := make([]string, 0, len(g.used))
+		for path := range g.used {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		g.printf("import (\n")
+		for _, path := range paths {
+			g.printf("%q\n", path)
+		}
+		g.printf(")\n\n")
+	}
+	g.buf.Write(body.Bytes())
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, g.buf.Bytes())
+	}
+
+	return src, nil
+}
+
+// parse collects the type declarations of the package in dir, excluding tests
+// and generated methods.
+func (g *generator) parse(dir string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != outputFilename
+	}, parser.SkipObjectResolution)
+	if err != nil {
+		return err
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("%s: expected a single package, found %d", dir, len(pkgs))
+	}
+
+	for name, pkg := range pkgs {
+		g.pkg = name
+		for _, file := range pkg.Files {
+			imports := fileImports(file)
+			for _, decl := range file.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok || gd.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					ts := spec.(*ast.TypeSpec)
+					g.types[ts.Name.Name] = ts
+					g.imports[ts] = imports
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// models returns the sorted names of the models of the package, i.e. its
+// structs with nullFields, along with the structs they reference.
+func (g *generator) models() []string {
+	seen := make(map[string]bool)
+
+	var visit func(expr ast.Expr)
+	visit = func(expr ast.Expr) {
+		if st, ok := expr.(*ast.StructType); ok {
+			for _, field := range st.Fields.List {
+				visit(field.Type)
+			}
+			return
+		}
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if _, ok := n.(*ast.SelectorExpr); ok {
+				return false
+			}
+			id, ok := n.(*ast.Ident)
+			if !ok || seen[id.Name] {
+				return true
+			}
+			if ts, ok := g.types[id.Name]; ok {
+				seen[id.Name] = true
+				visit(ts.Type)
+			}
+			return true
+		})
+	}
+
+	for name, ts := range g.types {
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok || seen[name] {
+			continue
+		}
+		for _, field := range st.Fields.List {
+			if len(field.Names) > 0 && field.Names[0].Name == "nullFields" {
+				seen[name] = true
+				visit(st)
+				break
+			}
+		}
+	}
+
+	var names []string
+	for name := range seen {
+		if _, ok := g.types[name].Type.(*ast.StructType); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (g *generator) writeMethods(name string) {
+	st := g.types[name].Type.(*ast.StructType)
+
+	g.printf("// DeepCopyInto copies the receiver into out, which must be non-nil,\n")
+	g.printf("// including its unexported fields.\n")
+	g.printf("func (in *%s) DeepCopyInto(out *%s) {\n", name, name)
+	g.printf("*out = *in\n")
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			g.writeField(embeddedName(field.Type), field.Type)
+			continue
+		}
+		for _, n := range field.Names {
+			g.writeField(n.Name, field.Type)
+		}
+	}
+	g.printf("}\n\n")
+
+	g.printf("// DeepCopy returns a deep copy of the receiver, including its unexported\n")
+	g.printf("// fields, e.g. its nullFields.\n")
+	g.printf("func (in *%s) DeepCopy() *%s {\n", name, name)
+	g.printf("if in == nil {\nreturn nil\n}\n")
+	g.printf("out := new(%s)\n", name)
+	g.printf("in.DeepCopyInto(out)\n")
+	g.printf("return out\n")
+	g.printf("}\n\n")
+
+	for _, field := range st.Fields.List {
+		if len(field.Names) > 0 && field.Names[0].Name == "nullFields" {
+			g.writeAccessors(name)
+			break
+		}
+	}
+}
+
+// writeAccessors writes the methods accessing the nullFields and
+// forceSendFields of the named model.
+func (g *generator) writeAccessors(name string) {
+	g.printf("// NullFields returns the names of the fields of the receiver that are sent\n")
+	g.printf("// as null, e.g. set to nil by their setter.\n")
+	g.printf("func (o *%s) NullFields() []string {\nreturn o.nullFields\n}\n\n", name)
+
+	g.printf("// SetNullFields sets the names of the fields of the receiver that are sent\n")
+	g.printf("// as null.\n")
+	g.printf("func (o *%s) SetNullFields(v []string) {\no.nullFields = v\n}\n\n", name)
+
+	g.printf("// ForceSendFields returns the names of the fields of the receiver that are\n")
+	g.printf("// sent even if empty.\n")
+	g.printf("func (o *%s) ForceSendFields() []string {\nreturn o.forceSendFields\n}\n\n", name)
+
+	g.printf("// SetForceSendFields sets the names of the fields of the receiver that are\n")
+	g.printf("// sent even if empty.\n")
+	g.printf("func (o *%s) SetForceSendFields(v []string) {\no.forceSendFields = v\n}\n\n", name)
+}
+
+// writeField writes the statements copying the named field, once the struct
+// is copied by assignment.
+func (g *generator) writeField(name string, expr ast.Expr) {
+	switch g.kindOf(expr) {
+	case kindStruct:
+		g.printf("in.%s.DeepCopyInto(&out.%s)\n", name, name)
+	case kindCopier:
+		g.printf("out.%s = in.%s.DeepCopy()\n", name, name)
+	case kindPointer:
+		if g.kindOf(g.underlying(expr).(*ast.StarExpr).X) == kindStruct {
+			g.printf("out.%s = in.%s.DeepCopy()\n", name, name)
+			return
+		}
+		fallthrough
+	case kindSlice, kindMap, kindInterface:
+		g.printf("if in.%s != nil {\n", name)
+		g.printf("in, out := &in.%s, &out.%s\n", name, name)
+		g.writeCopy("*out", "*in", expr)
+		g.printf("}\n")
+	}
+}
+
+// writeCopy writes the statements setting dst to a deep copy of src, a
+// non-nil value of the given type.
+func (g *generator) writeCopy(dst, src string, expr ast.Expr) {
+	switch g.kindOf(expr) {
+	case kindValue:
+		g.printf("%s = %s\n", dst, src)
+	case kindStruct:
+		g.printf("%s.DeepCopyInto(&%s)\n", src, dst)
+	case kindCopier:
+		g.printf("%s = %s.DeepCopy()\n", dst, src)
+	case kindInterface:
+		g.used[jsonutilImportPath] = true
+		g.printf("%s = jsonutil.DeepCopyValue(%s)\n", dst, src)
+	case kindPointer:
+		elem := g.underlying(expr).(*ast.StarExpr).X
+		if g.kindOf(elem) == kindStruct {
+			g.printf("%s = %s.DeepCopy()\n", dst, src)
+			return
+		}
+		g.printf("%s = new(%s)\n", dst, g.typeString(elem))
+		g.writeCopy("*"+dst, "*"+src, elem)
+	case kindSlice:
+		g.printf("%s = make(%s, len(%s))\n", dst, g.typeString(expr), src)
+		elem := g.elem(expr)
+		if g.kindOf(elem) == kindValue {
+			g.printf("copy(%s, %s)\n", dst, src)
+			return
+		}
+		g.printf("for i := range %s {\n", src)
+		g.writeElem(fmt.Sprintf("(%s)[i]", dst), fmt.Sprintf("(%s)[i]", src), elem)
+		g.printf("}\n")
+	case kindMap:
+		g.printf("%s = make(%s, len(%s))\n", dst, g.typeString(expr), src)
+		g.printf("for key, val := range %s {\n", src)
+		g.writeElem(fmt.Sprintf("(%s)[key]", dst), "val", g.elem(expr))
+		g.printf("}\n")
+	}
+}
+
+// writeElem writes the statements setting dst to a deep copy of src, an
+// element of a slice or map of the given type, which may be nil.
+func (g *generator) writeElem(dst, src string, expr ast.Expr) {
+	switch g.kindOf(expr) {
+	case kindPointer, kindSlice, kindMap:
+		if g.kindOf(expr) != kindPointer || g.kindOf(g.underlying(expr).(*ast.StarExpr).X) != kindStruct {
+			g.printf("if %s != nil {\n", src)
+			g.writeCopy(dst, src, expr)
+			g.printf("}\n")
+			return
+		}
+	case kindStruct:
+		if !strings.HasSuffix(dst, "[i]") {
+			g.fail(fmt.Errorf("unsupported map of structs %s", g.typeString(expr)))
+			return
+		}
+	}
+	g.writeCopy(dst, src, expr)
+}
+
+// kindOf returns the kind of the given type.
+func (g *generator) kindOf(expr ast.Expr) kind {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(t.Name) != nil {
+			return kindValue
+		}
+		ts, ok := g.types[t.Name]
+		if !ok {
+			g.fail(fmt.Errorf("unknown type %s", t.Name))
+			return kindValue
+		}
+		if _, ok := ts.Type.(*ast.StructType); ok {
+			return kindStruct
+		}
+		return g.kindOf(ts.Type)
+	case *ast.SelectorExpr:
+		k, ok := externalTypes[g.qualifiedName(t)]
+		if !ok {
+			g.fail(fmt.Errorf("unsupported type %s", types.ExprString(t)))
+		}
+		return k
+	case *ast.StarExpr:
+		return kindPointer
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return kindSlice
+		}
+	case *ast.MapType:
+		if g.kindOf(t.Key) == kindValue {
+			return kindMap
+		}
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return kindInterface
+		}
+	}
+
+	g.fail(fmt.Errorf("unsupported type %s", types.ExprString(expr)))
+	return kindValue
+}
+
+// underlying returns the type underlying the given one, if declared by the
+// package.
+func (g *generator) underlying(expr ast.Expr) ast.Expr {
+	if id, ok := expr.(*ast.Ident); ok {
+		if ts, ok := g.types[id.Name]; ok {
+			return g.underlying(ts.Type)
+		}
+	}
+	return expr
+}
+
+// elem returns the type of the elements of the given slice or map type.
+func (g *generator) elem(expr ast.Expr) ast.Expr {
+	switch t := g.underlying(expr).(type) {
+	case *ast.ArrayType:
+		return t.Elt
+	case *ast.MapType:
+		return t.Value
+	case *ast.SelectorExpr:
+		if g.qualifiedName(t) == "encoding/json.RawMessage" {
+			return ast.NewIdent("byte")
+		}
+	}
+
+	g.fail(fmt.Errorf("unsupported type %s", types.ExprString(expr)))
+	return ast.NewIdent("byte")
+}
+
+// qualifiedName returns the import path and name of the given type, e.g.
+// "encoding/json.RawMessage".
+func (g *generator) qualifiedName(sel *ast.SelectorExpr) string {
+	x := sel.X.(*ast.Ident).Name
+	for _, imports := range g.imports {
+		if path, ok := imports[x]; ok {
+			return path + "." + sel.Sel.Name
+		}
+	}
+	return x + "." + sel.Sel.Name
+}
+
+// typeString returns the given type as written in the generated code, and
+// records the imports it needs.
+func (g *generator) typeString(expr ast.Expr) string {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			name := g.qualifiedName(sel)
+			g.used[name[:strings.LastIndex(name, ".")]] = true
+			return false
+		}
+		return true
+	})
+	return types.ExprString(expr)
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) fail(err error) {
+	if g.err == nil {
+		g.err = err
+	}
+}
+
+// embeddedName returns the name of an embedded field of the given type.
+func embeddedName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+	return expr.(*ast.Ident).Name
+}
+
+// fileImports returns the import paths of a file, keyed by package name.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDeepCopyUpToDate fails if a model changed without regenerating its deep
+// copy methods (run `make generate`).
+func TestDeepCopyUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "service")
+	directive := []byte("//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/deepcopygen\n")
+
+	var n int
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+
+		b, err := ioutil.ReadFile(path)
+		if err != nil || !bytes.Contains(b, directive) {
+			return err
+		}
+		n++
+
+		dir := filepath.Dir(path)
+		want, err := generate(dir)
+		if err != nil {
+			t.Errorf("%s: %v", dir, err)
+			return nil
+		}
+
+		got, err := ioutil.ReadFile(filepath.Join(dir, outputFilename))
+		if err != nil {
+			t.Errorf("%s: %v", dir, err)
+			return nil
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: deep copy methods are out of date, run `make generate`", dir)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 {
+		t.Fatal("no go:generate directives found")
+	}
+}
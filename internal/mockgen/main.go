@@ -0,0 +1,492 @@
+// Command mockgen generates the mock of a package's Service interface into its
+// mocks subpackage. It is meant to be run by go generate, from a directive in
+// the file declaring the interface:
+//
+//	//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen
+//
+// Run `make generate` after changing a Service interface to keep its mock in
+// sync. Generated mocks assert that they implement the interface, so a stale
+// mock fails to compile.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	mockImportPath = "github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+	outputFilename = "service.go"
+)
+
+// reserved holds the names of the methods of mock.Mock, that mocked methods
+// must not shadow.
+var reserved = map[string]bool{
+	"Record":      true,
+	"Calls":       true,
+	"Called":      true,
+	"Returns":     true,
+	"ReturnsOnce": true,
+	"Result":      true,
+	"Reset":       true,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mockgen: ")
+
+	var (
+		source = flag.String("source", os.Getenv("GOFILE"), "file declaring the interface")
+		iface  = flag.String("interface", "Service", "name of the interface to mock")
+		output = flag.String("output", "mocks", "output directory, relative to the source file")
+	)
+	flag.Parse()
+
+	if *source == "" {
+		log.Fatal("no source file; run with go generate or set -source")
+	}
+
+	src, err := generate(*source, *iface)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dir := filepath.Join(filepath.Dir(*source), *output)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, outputFilename), src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+	Zero    []string
+}
+
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// Signature returns the method's parameters and results, e.g.
+// "(ctx context.Context, input *aws.ReadGroupInput) (*aws.ReadGroupOutput, error)".
+func (m *method) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Name + " " + p.Type
+	}
+	return "(" + strings.Join(params, ", ") + ")" + m.results()
+}
+
+// FuncType returns the type of the method's function override.
+func (m *method) FuncType() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Type
+	}
+	return "func(" + strings.Join(params, ", ") + ")" + m.results()
+}
+
+// Args returns the arguments passed to the method's function override.
+func (m *method) Args() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+		if p.Variadic {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// RecordArgs returns the arguments recorded by the method.
+func (m *method) RecordArgs() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+	}
+	return strings.Join(args, ", ")
+}
+
+// Defaults returns the assignments of the default values of the results that
+// differ from zero values.
+func (m *method) Defaults() []string {
+	var defaults []string
+	for i, z := range m.Zero {
+		if z != "" {
+			defaults = append(defaults, fmt.Sprintf("r%d = %s", i, z))
+		}
+	}
+	return defaults
+}
+
+func (m *method) results() string {
+	switch len(m.Results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + m.Results[0]
+	}
+	return " (" + strings.Join(m.Results, ", ") + ")"
+}
+
+type data struct {
+	Package    string
+	ImportPath string
+	Interface  string
+	StdImports []string
+	Imports    []string
+	Methods    []*method
+}
+
+// generate returns the source of the mock of the named interface declared in
+// the given file.
+func generate(filename, name string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath, err := packageImportPath(filepath.Dir(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	it := findInterface(file, name)
+	if it == nil {
+		return nil, fmt.Errorf("%s: interface %s not found", filename, name)
+	}
+
+	q := &qualifier{
+		pkg:     file.Name.Name,
+		imports: fileImports(file),
+		used:    map[string]bool{importPath: true, mockImportPath: true},
+	}
+
+	d := &data{
+		Package:    file.Name.Name,
+		ImportPath: importPath,
+		Interface:  name,
+	}
+
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return nil, fmt.Errorf("%s: embedded interfaces are not supported",
+				fset.Position(field.Pos()))
+		}
+		for _, n := range field.Names {
+			if reserved[n.Name] {
+				return nil, fmt.Errorf("%s: method %s shadows mock.Mock.%s",
+					fset.Position(n.Pos()), n.Name, n.Name)
+			}
+			d.Methods = append(d.Methods, newMethod(n.Name, ft, q))
+		}
+	}
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	// Canned values of the wrong type are reported with fmt.
+	for _, m := range d.Methods {
+		if len(m.Results) > 0 {
+			q.used["fmt"] = true
+			break
+		}
+	}
+
+	for path := range q.used {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			d.Imports = append(d.Imports, path)
+		} else {
+			d.StdImports = append(d.StdImports, path)
+		}
+	}
+	sort.Strings(d.StdImports)
+	sort.Strings(d.Imports)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+
+	return src, nil
+}
+
+func newMethod(name string, ft *ast.FuncType, q *qualifier) *method {
+	m := &method{Name: name}
+
+	var params []*ast.Field
+	for _, field := range ft.Params.List {
+		if len(field.Names) == 0 {
+			params = append(params, field)
+			continue
+		}
+		for _, n := range field.Names {
+			params = append(params, &ast.Field{Names: []*ast.Ident{n}, Type: field.Type})
+		}
+	}
+
+	for i, field := range params {
+		p := param{Name: paramName(i, field, len(params))}
+		if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+			p.Variadic = true
+			p.Type = "..." + q.typeString(ellipsis.Elt)
+		} else {
+			p.Type = q.typeString(field.Type)
+		}
+		m.Params = append(m.Params, p)
+	}
+
+	if ft.Results != nil {
+		for _, field := range ft.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.Results = append(m.Results, q.typeString(field.Type))
+				m.Zero = append(m.Zero, zeroValue(field.Type, q))
+			}
+		}
+	}
+
+	return m
+}
+
+// paramName returns the name of the i-th of n parameters, or a conventional
+// one if it is unnamed: ctx for a context, input for a single input, or argN.
+func paramName(i int, field *ast.Field, n int) string {
+	if len(field.Names) > 0 && field.Names[0].Name != "_" {
+		return field.Names[0].Name
+	}
+	if sel, ok := field.Type.(*ast.SelectorExpr); ok && sel.Sel.Name == "Context" {
+		if x, ok := sel.X.(*ast.Ident); ok && x.Name == "context" {
+			return "ctx"
+		}
+	}
+	if n == 1 || (n == 2 && i == 1) {
+		return "input"
+	}
+	return "arg" + strconv.Itoa(i)
+}
+
+// zeroValue returns the default value of a result, if it differs from the
+// zero value of its type: pointers to structs default to a new struct, so that
+// callers can access the fields of the outputs of unconfigured mocks.
+func zeroValue(expr ast.Expr, q *qualifier) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		return "new(" + q.typeString(star.X) + ")"
+	}
+	return ""
+}
+
+func findInterface(file *ast.File, name string) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if it, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == name {
+				return it
+			}
+		}
+	}
+	return nil
+}
+
+// fileImports returns the import paths of a file, keyed by package name.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// packageImportPath returns the import path of the package in dir, based on
+// the path of the enclosing module.
+func packageImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for root := dir; ; root = filepath.Dir(root) {
+		b, err := ioutil.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", err
+			}
+			return filepath.ToSlash(filepath.Join(modulePath(b), rel)), nil
+		}
+		if filepath.Dir(root) == root {
+			return "", errors.New("go.mod not found")
+		}
+	}
+}
+
+func modulePath(mod []byte) string {
+	for _, line := range strings.Split(string(mod), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+	return ""
+}
+
+// A qualifier prints types as seen from the mocks package: types declared by
+// the mocked package are qualified with its name, and the imports they need
+// are recorded.
+type qualifier struct {
+	pkg     string
+	imports map[string]string
+	used    map[string]bool
+	err     error
+}
+
+func (q *qualifier) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(t.Name) != nil {
+			return t.Name
+		}
+		return q.pkg + "." + t.Name
+	case *ast.SelectorExpr:
+		x := t.X.(*ast.Ident).Name
+		path, ok := q.imports[x]
+		if !ok {
+			q.err = fmt.Errorf("unknown package %s", x)
+		}
+		q.used[path] = true
+		return x + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + q.typeString(t.X)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + q.typeString(t.Elt)
+		}
+		return "[" + t.Len.(*ast.BasicLit).Value + "]" + q.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + q.typeString(t.Key) + "]" + q.typeString(t.Value)
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	case *ast.ChanType:
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + q.typeString(t.Value)
+		case ast.RECV:
+			return "<-chan " + q.typeString(t.Value)
+		}
+		return "chan " + q.typeString(t.Value)
+	case *ast.FuncType:
+		m := newMethod("", t, q)
+		return m.FuncType()
+	}
+
+	q.err = fmt.Errorf("unsupported type %T", expr)
+	return ""
+}
+
+var tmpl = template.Must(template.New("mock").Parse(`// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of {{.Package}}.{{.Interface}}.
+package mocks
+
+import (
+{{- range .StdImports}}
+	"{{.}}"
+{{- end}}
+{{if .StdImports}}
+{{end}}
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+
+// {{.Interface}} is a mock of {{.Package}}.{{.Interface}}. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. {{(index .Methods 0).Name}}Func)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type {{.Interface}} struct {
+	mock.Mock
+{{range .Methods}}
+	// {{.Name}}Func, if set, is called by {{.Name}}.
+	{{.Name}}Func {{.FuncType}}
+{{end -}}
+}
+
+var _ {{.Package}}.{{.Interface}} = (*{{.Interface}})(nil)
+{{$iface := .Interface}}
+{{- range .Methods}}
+{{- $name := .Name}}
+// {{.Name}} records the call and returns the configured results.
+func (m *{{$iface}}) {{.Name}}{{.Signature}} {
+	m.Record("{{.Name}}"{{if .Params}}, {{.RecordArgs}}{{end}})
+	if m.{{.Name}}Func != nil {
+		{{if .Results}}return {{end}}m.{{.Name}}Func({{.Args}})
+		{{- if not .Results}}
+		return
+		{{- end}}
+	}
+	{{- if .Results}}
+
+	var (
+	{{- range $i, $r := .Results}}
+		r{{$i}} {{$r}}
+	{{- end}}
+	)
+	if rs, ok := m.Result("{{.Name}}", {{len .Results}}); ok {
+	{{- range $i, $r := .Results}}
+		if r{{$i}}, ok = rs[{{$i}}].({{$r}}); !ok && rs[{{$i}}] != nil {
+			panic(fmt.Sprintf("mock: {{$name}} returns {{$r}} as result {{$i}}, got canned value of type %T", rs[{{$i}}]))
+		}
+	{{- end}}
+	}{{if .Defaults}} else {
+	{{- range .Defaults}}
+		{{.}}
+	{{- end}}
+	}{{end}}
+
+	return {{range $i, $r := .Results}}{{if $i}}, {{end}}r{{$i}}{{end}}
+	{{- end}}
+}
+{{end}}`))
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMocksUpToDate fails if a Service interface changed without regenerating
+// its mock (run `make generate`).
+func TestMocksUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "service")
+	directive := []byte("//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen\n")
+
+	var n int
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+
+		b, err := ioutil.ReadFile(path)
+		if err != nil || !bytes.Contains(b, directive) {
+			return err
+		}
+		n++
+
+		want, err := generate(path, "Service")
+		if err != nil {
+			t.Errorf("%s: %v", path, err)
+			return nil
+		}
+
+		got, err := ioutil.ReadFile(filepath.Join(filepath.Dir(path), "mocks", outputFilename))
+		if err != nil {
+			t.Errorf("%s: %v", path, err)
+			return nil
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: mock is out of date, run `make generate`", path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 {
+		t.Fatal("no go:generate directives found")
+	}
+}
@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	elastigroupazure "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	elastigroupgcp "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	managedinstanceaws "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	oceangcp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeepCopy deeply copies every model reachable from the input of an API
+// operation, and verifies that the copy is identical to the original and
+// shares no memory with it.
+func TestDeepCopy(t *testing.T) {
+	services := map[string]reflect.Type{
+		"elastigroup/aws":     reflect.TypeOf(elastigroupaws.ServiceOp{}),
+		"elastigroup/azure":   reflect.TypeOf(elastigroupazure.ServiceOp{}),
+		"elastigroup/gcp":     reflect.TypeOf(elastigroupgcp.ServiceOp{}),
+		"ocean/aws":           reflect.TypeOf(oceanaws.ServiceOp{}),
+		"ocean/gcp":           reflect.TypeOf(oceangcp.ServiceOp{}),
+		"managedinstance/aws": reflect.TypeOf(managedinstanceaws.ServiceOp{}),
+		"mrscaler":            reflect.TypeOf(mrscaler.ServiceOp{}),
+		"multai":              reflect.TypeOf(multai.ServiceOp{}),
+		"healthcheck":         reflect.TypeOf(healthcheck.ServiceOp{}),
+		"subscription":        reflect.TypeOf(subscription.ServiceOp{}),
+	}
+
+	var n int
+	for name, svc := range services {
+		pt := reflect.PtrTo(svc)
+		for i := 0; i < pt.NumMethod(); i++ {
+			mt := pt.Method(i).Type
+			if mt.NumIn() != 3 || !strings.HasSuffix(mt.In(2).Elem().Name(), "Input") {
+				continue
+			}
+
+			input := reflect.New(mt.In(2).Elem()).Elem()
+			for j := 0; j < input.NumField(); j++ {
+				model := input.Field(j)
+				deepCopy := model.MethodByName("DeepCopy")
+				if model.Kind() != reflect.Ptr || !deepCopy.IsValid() {
+					continue
+				}
+				n++
+
+				t.Run(name+"/"+model.Type().Elem().Name(), func(t *testing.T) {
+					populate(model, 0)
+					populateState(model)
+
+					clone := deepCopy.Call(nil)[0]
+					assert.Equal(t, model.Interface(), clone.Interface(), "expected the copy to be identical")
+
+					addrs := make(map[uintptr]string)
+					collectAddrs(model, "", addrs)
+					checkAddrs(t, clone, "", addrs)
+				})
+			}
+		}
+	}
+	if n == 0 {
+		t.Fatal("no models found")
+	}
+}
+
+// populateState sets the unexported state of the models reachable from v, e.g.
+// their nullFields.
+func populateState(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			populateState(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			switch v.Type().Field(i).Name {
+			case "forceSendFields", "nullFields":
+				f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+				f.Set(reflect.ValueOf([]string{"Test"}))
+			case "unknownFields":
+				f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+				f.Set(reflect.MakeMap(f.Type()))
+				f.SetMapIndex(reflect.ValueOf("test"), reflect.ValueOf([]byte("1")).Convert(f.Type().Elem()))
+			default:
+				if f.CanSet() {
+					populateState(f)
+				}
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			populateState(v.Index(i))
+		}
+	}
+}
+
+// collectAddrs records the addresses of the memory referenced by v, with the
+// paths of their references.
+func collectAddrs(v reflect.Value, path string, addrs map[uintptr]string) {
+	walkRefs(v, path, func(addr uintptr, path string) {
+		addrs[addr] = path
+	})
+}
+
+// checkAddrs fails if v references any of the given addresses.
+func checkAddrs(t *testing.T, v reflect.Value, path string, addrs map[uintptr]string) {
+	walkRefs(v, path, func(addr uintptr, path string) {
+		if orig, ok := addrs[addr]; ok {
+			t.Errorf("expect no shared memory, got %s sharing %s", path, orig)
+		}
+	})
+}
+
+// walkRefs calls fn with the address of every non-empty pointer, slice and map
+// reachable from v, including through unexported fields.
+func walkRefs(v reflect.Value, path string, fn func(addr uintptr, path string)) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		if v.Elem().Type().Size() > 0 {
+			fn(v.Pointer(), path)
+		}
+		walkRefs(v.Elem(), path, fn)
+	case reflect.Interface:
+		if !v.IsNil() {
+			walkRefs(v.Elem(), path, fn)
+		}
+	case reflect.Struct:
+		if !v.CanAddr() {
+			c := reflect.New(v.Type()).Elem()
+			c.Set(v)
+			v = c
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+			walkRefs(f, path+"."+v.Type().Field(i).Name, fn)
+		}
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return
+		}
+		fn(v.Pointer(), path)
+		for i := 0; i < v.Len(); i++ {
+			walkRefs(v.Index(i), path+"[]", fn)
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		fn(v.Pointer(), path)
+		for _, k := range v.MapKeys() {
+			walkRefs(v.MapIndex(k), path+"[]", fn)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package elastigroup
 
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen
+
 import (
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
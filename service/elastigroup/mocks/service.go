@@ -0,0 +1,92 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of elastigroup.Service.
+package mocks
+
+import (
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of elastigroup.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. CloudProviderAWSFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// CloudProviderAWSFunc, if set, is called by CloudProviderAWS.
+	CloudProviderAWSFunc func() aws.Service
+
+	// CloudProviderAzureFunc, if set, is called by CloudProviderAzure.
+	CloudProviderAzureFunc func() azure.Service
+
+	// CloudProviderGCPFunc, if set, is called by CloudProviderGCP.
+	CloudProviderGCPFunc func() gcp.Service
+}
+
+var _ elastigroup.Service = (*Service)(nil)
+
+// CloudProviderAWS records the call and returns the configured results.
+func (m *Service) CloudProviderAWS() aws.Service {
+	m.Record("CloudProviderAWS")
+	if m.CloudProviderAWSFunc != nil {
+		return m.CloudProviderAWSFunc()
+	}
+
+	var (
+		r0 aws.Service
+	)
+	if rs, ok := m.Result("CloudProviderAWS", 1); ok {
+		if r0, ok = rs[0].(aws.Service); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CloudProviderAWS returns aws.Service as result 0, got canned value of type %T", rs[0]))
+		}
+	}
+
+	return r0
+}
+
+// CloudProviderAzure records the call and returns the configured results.
+func (m *Service) CloudProviderAzure() azure.Service {
+	m.Record("CloudProviderAzure")
+	if m.CloudProviderAzureFunc != nil {
+		return m.CloudProviderAzureFunc()
+	}
+
+	var (
+		r0 azure.Service
+	)
+	if rs, ok := m.Result("CloudProviderAzure", 1); ok {
+		if r0, ok = rs[0].(azure.Service); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CloudProviderAzure returns azure.Service as result 0, got canned value of type %T", rs[0]))
+		}
+	}
+
+	return r0
+}
+
+// CloudProviderGCP records the call and returns the configured results.
+func (m *Service) CloudProviderGCP() gcp.Service {
+	m.Record("CloudProviderGCP")
+	if m.CloudProviderGCPFunc != nil {
+		return m.CloudProviderGCPFunc()
+	}
+
+	var (
+		r0 gcp.Service
+	)
+	if rs, ok := m.Result("CloudProviderGCP", 1); ok {
+		if r0, ok = rs[0].(gcp.Service); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CloudProviderGCP returns gcp.Service as result 0, got canned value of type %T", rs[0]))
+		}
+	}
+
+	return r0
+}
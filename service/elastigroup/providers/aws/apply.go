@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/diff"
+)
+
+// launchSpecPaths are the paths of the fields whose changes only apply to the
+// instances of a group once it is rolled.
+var launchSpecPaths = []string{"compute.launchSpecification"}
+
+type ApplyGroupInput struct {
+	// The desired state of the group. The existing group is looked up by its
+	// ID if set, or else by its name.
+	Group *Group `json:"group,omitempty"`
+
+	// If true, the changes are computed but not sent.
+	PlanOnly *bool `json:"planOnly,omitempty"`
+
+	// If set, the group is rolled with these settings after an update
+	// changing its launch specification. Its GroupID is set by Apply.
+	Roll *RollGroupInput `json:"roll,omitempty"`
+}
+
+type ApplyGroupOutput struct {
+	// The group as created or updated, or the existing group, if any, if
+	// unchanged or in plan-only mode.
+	Group *Group `json:"group,omitempty"`
+
+	// What was done, or would be done in plan-only mode, to the group.
+	Action diff.Action `json:"action"`
+
+	// The changes made, or to be made in plan-only mode, to the group.
+	Changes diff.Changes `json:"changes,omitempty"`
+
+	// The output of the roll of the group, if rolled.
+	Roll *RollGroupOutput `json:"roll,omitempty"`
+}
+
+// Apply reconciles a group with its desired state, as diff.Apply does: it
+// looks up the existing group by the ID of the desired one if set, or else by
+// its name, creates it if it does not exist, or else updates the fields that
+// the desired state sets or explicitly nulls only. If the roll following an
+// update fails, the output is returned along with the error.
+func Apply(ctx context.Context, svc Service, input *ApplyGroupInput) (*ApplyGroupOutput, error) {
+	output := new(ApplyGroupOutput)
+
+	r := &diff.Resource{
+		Kind: "group",
+		Read: func(ctx context.Context, id string) (interface{}, error) {
+			out, err := svc.Read(ctx, &ReadGroupInput{GroupID: spotinst.String(id)})
+			if err != nil {
+				return nil, err
+			}
+			return out.Group, nil
+		},
+		List: func(ctx context.Context) (interface{}, error) {
+			out, err := svc.List(ctx, &ListGroupsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return out.Groups, nil
+		},
+		Create: func(ctx context.Context, desired interface{}) (interface{}, error) {
+			// The group may have been deleted, so it gets a new ID.
+			group := *desired.(*Group)
+			group.ID = nil
+
+			out, err := svc.Create(ctx, &CreateGroupInput{Group: &group})
+			if err != nil {
+				return nil, err
+			}
+			return out.Group, nil
+		},
+		Update: func(ctx context.Context, update interface{}) (interface{}, error) {
+			out, err := svc.Update(ctx, &UpdateGroupInput{Group: update.(*Group)})
+			if err != nil {
+				return nil, err
+			}
+			return out.Group, nil
+		},
+		RollPaths: launchSpecPaths,
+	}
+
+	if input.Roll != nil {
+		r.Roll = func(ctx context.Context, id string) (err error) {
+			roll := *input.Roll
+			roll.GroupID = spotinst.String(id)
+
+			output.Roll, err = svc.Roll(ctx, &roll)
+			return err
+		}
+	}
+
+	result, err := diff.Apply(ctx, r, input.Group, spotinst.BoolValue(input.PlanOnly))
+	if result == nil {
+		return nil, err
+	}
+
+	output.Group, _ = result.Resource.(*Group)
+	output.Action = result.Action
+	output.Changes = result.Changes
+
+	return output, err
+}
@@ -90,6 +90,10 @@ type Group struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Integration struct {
@@ -109,6 +113,7 @@ type Integration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceHealth struct {
@@ -129,6 +134,7 @@ type AutoScale struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleECS struct {
@@ -138,6 +144,7 @@ type AutoScaleECS struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleKubernetes struct {
@@ -146,6 +153,7 @@ type AutoScaleKubernetes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleNomad struct {
@@ -154,6 +162,7 @@ type AutoScaleNomad struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleDockerSwarm struct {
@@ -161,6 +170,7 @@ type AutoScaleDockerSwarm struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleHeadroom struct {
@@ -171,6 +181,7 @@ type AutoScaleHeadroom struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleDown struct {
@@ -179,6 +190,7 @@ type AutoScaleDown struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleConstraint struct {
@@ -187,6 +199,7 @@ type AutoScaleConstraint struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleLabel struct {
@@ -195,6 +208,7 @@ type AutoScaleLabel struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleAttributes struct {
@@ -212,6 +226,7 @@ type ElasticBeanstalkIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BeanstalkManagedActions struct {
@@ -219,6 +234,7 @@ type BeanstalkManagedActions struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BeanstalkPlatformUpdate struct {
@@ -228,6 +244,7 @@ type BeanstalkPlatformUpdate struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BeanstalkDeploymentPreferences struct {
@@ -238,6 +255,7 @@ type BeanstalkDeploymentPreferences struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BeanstalkDeploymentStrategy struct {
@@ -246,6 +264,7 @@ type BeanstalkDeploymentStrategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type CodeDeployIntegration struct {
@@ -255,6 +274,7 @@ type CodeDeployIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type DeploymentGroup struct {
@@ -263,6 +283,7 @@ type DeploymentGroup struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type OpsWorksIntegration struct {
@@ -271,6 +292,7 @@ type OpsWorksIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RancherIntegration struct {
@@ -281,6 +303,7 @@ type RancherIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type EC2ContainerServiceIntegration struct {
@@ -289,6 +312,7 @@ type EC2ContainerServiceIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type KubernetesIntegration struct {
@@ -300,6 +324,7 @@ type KubernetesIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type MesosphereIntegration struct {
@@ -307,6 +332,7 @@ type MesosphereIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type MultaiIntegration struct {
@@ -314,6 +340,7 @@ type MultaiIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type NomadIntegration struct {
@@ -324,6 +351,7 @@ type NomadIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ChefIntegration struct {
@@ -335,6 +363,7 @@ type ChefIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type DockerSwarmIntegration struct {
@@ -344,6 +373,7 @@ type DockerSwarmIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Route53Integration struct {
@@ -351,6 +381,7 @@ type Route53Integration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Domain struct {
@@ -360,6 +391,7 @@ type Domain struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RecordSet struct {
@@ -368,6 +400,7 @@ type RecordSet struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type GitlabIntegration struct {
@@ -375,6 +408,7 @@ type GitlabIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type GitlabRunner struct {
@@ -382,6 +416,7 @@ type GitlabRunner struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Scheduling struct {
@@ -389,6 +424,7 @@ type Scheduling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Task struct {
@@ -410,6 +446,7 @@ type Task struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Scaling struct {
@@ -419,6 +456,7 @@ type Scaling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ScalingPolicy struct {
@@ -444,6 +482,7 @@ type ScalingPolicy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Action struct {
@@ -457,6 +496,7 @@ type Action struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Dimension struct {
@@ -465,6 +505,7 @@ type Dimension struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Predictive struct {
@@ -472,6 +513,7 @@ type Predictive struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Strategy struct {
@@ -490,6 +532,7 @@ type Strategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Persistence struct {
@@ -500,6 +543,7 @@ type Persistence struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RevertToSpot struct {
@@ -508,6 +552,7 @@ type RevertToSpot struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ScalingStrategy struct {
@@ -516,6 +561,7 @@ type ScalingStrategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Signal struct {
@@ -524,6 +570,7 @@ type Signal struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Capacity struct {
@@ -534,6 +581,7 @@ type Capacity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Compute struct {
@@ -549,6 +597,7 @@ type Compute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type EBSVolume struct {
@@ -557,6 +606,7 @@ type EBSVolume struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceTypes struct {
@@ -567,6 +617,7 @@ type InstanceTypes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceTypeWeight struct {
@@ -575,6 +626,7 @@ type InstanceTypeWeight struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AvailabilityZone struct {
@@ -584,6 +636,7 @@ type AvailabilityZone struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LaunchSpecification struct {
@@ -608,6 +661,7 @@ type LaunchSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LoadBalancersConfig struct {
@@ -615,6 +669,7 @@ type LoadBalancersConfig struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LoadBalancer struct {
@@ -628,6 +683,7 @@ type LoadBalancer struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type NetworkInterface struct {
@@ -644,6 +700,7 @@ type NetworkInterface struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BlockDeviceMapping struct {
@@ -653,6 +710,7 @@ type BlockDeviceMapping struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type EBS struct {
@@ -666,6 +724,7 @@ type EBS struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type IAMInstanceProfile struct {
@@ -674,6 +733,7 @@ type IAMInstanceProfile struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type CreditSpecification struct {
@@ -681,6 +741,7 @@ type CreditSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Instance struct {
@@ -702,6 +763,7 @@ type RollStrategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type StatefulDeallocation struct {
@@ -1086,6 +1148,10 @@ func (s *ServiceOp) List(ctx context.Context, input *ListGroupsInput) (*ListGrou
 }
 
 func (s *ServiceOp) Create(ctx context.Context, input *CreateGroupInput) (*CreateGroupOutput, error) {
+	if err := s.Client.ValidateCreate(input.Group); err != nil {
+		return nil, err
+	}
+
 	r := client.NewRequest(http.MethodPost, "/aws/ec2/group")
 	r.Obj = input
 
@@ -1137,6 +1203,10 @@ func (s *ServiceOp) Read(ctx context.Context, input *ReadGroupInput) (*ReadGroup
 }
 
 func (s *ServiceOp) Update(ctx context.Context, input *UpdateGroupInput) (*UpdateGroupOutput, error) {
+	if err := s.Client.Validate(input.Group); err != nil {
+		return nil, err
+	}
+
 	path, err := uritemplates.Expand("/aws/ec2/group/{groupId}", uritemplates.Values{
 		"groupId": spotinst.StringValue(input.Group.ID),
 	})
@@ -1145,10 +1215,13 @@ func (s *ServiceOp) Update(ctx context.Context, input *UpdateGroupInput) (*Updat
 	}
 
 	// We do NOT need the ID anymore, so let's drop it.
-	input.Group.ID = nil
+	in := *input
+	group := *input.Group
+	group.ID = nil
+	in.Group = &group
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	if input.ShouldResumeStateful != nil {
 		r.Params.Set("shouldResumeStateful",
@@ -1287,14 +1360,15 @@ func (s *ServiceOp) StopDeployment(ctx context.Context, input *StopDeploymentInp
 		return nil, err
 	}
 
-	input.GroupID = nil
-	input.RollID = nil
+	in := *input
+	in.GroupID = nil
+	in.RollID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	input.Roll = &Roll{
+	in.Roll = &Roll{
 		Status: spotinst.String("STOPPED"),
 	}
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1314,10 +1388,12 @@ func (s *ServiceOp) Detach(ctx context.Context, input *DetachGroupInput) (*Detac
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.NotIdempotent = true
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1337,10 +1413,12 @@ func (s *ServiceOp) Roll(ctx context.Context, input *RollGroupInput) (*RollGroup
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.NotIdempotent = true
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1365,10 +1443,11 @@ func (s *ServiceOp) RollECS(ctx context.Context, input *RollECSGroupInput) (*Rol
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodPost, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1536,6 +1615,7 @@ func (s *ServiceOp) StartBeanstalkMaintenance(ctx context.Context, input *Beanst
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.NotIdempotent = true
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -1579,6 +1659,7 @@ func (s *ServiceOp) FinishBeanstalkMaintenance(ctx context.Context, input *Beans
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.NotIdempotent = true
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -1595,7 +1676,12 @@ func (s *ServiceOp) FinishBeanstalkMaintenance(ctx context.Context, input *Beans
 func (o Group) MarshalJSON() ([]byte, error) {
 	type noMethod Group
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Group) UnmarshalJSON(b []byte) error {
+	type noMethod Group
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Group) SetId(v *string) *Group {
@@ -1675,7 +1761,12 @@ func (o *Group) SetRegion(v *string) *Group {
 func (o Integration) MarshalJSON() ([]byte, error) {
 	type noMethod Integration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Integration) UnmarshalJSON(b []byte) error {
+	type noMethod Integration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Integration) SetRoute53(v *Route53Integration) *Integration {
@@ -1776,7 +1867,12 @@ func (o *Integration) SetGitlab(v *GitlabIntegration) *Integration {
 func (o RancherIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod RancherIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RancherIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod RancherIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RancherIntegration) SetMasterHost(v *string) *RancherIntegration {
@@ -1814,7 +1910,12 @@ func (o *RancherIntegration) SetVersion(v *string) *RancherIntegration {
 func (o ElasticBeanstalkIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod ElasticBeanstalkIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ElasticBeanstalkIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod ElasticBeanstalkIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ElasticBeanstalkIntegration) SetEnvironmentID(v *string) *ElasticBeanstalkIntegration {
@@ -1845,7 +1946,12 @@ func (o *ElasticBeanstalkIntegration) SetDeploymentPreferences(v *BeanstalkDeplo
 func (o BeanstalkManagedActions) MarshalJSON() ([]byte, error) {
 	type noMethod BeanstalkManagedActions
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BeanstalkManagedActions) UnmarshalJSON(b []byte) error {
+	type noMethod BeanstalkManagedActions
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BeanstalkManagedActions) SetPlatformUpdate(v *BeanstalkPlatformUpdate) *BeanstalkManagedActions {
@@ -1862,7 +1968,12 @@ func (o *BeanstalkManagedActions) SetPlatformUpdate(v *BeanstalkPlatformUpdate)
 func (o BeanstalkPlatformUpdate) MarshalJSON() ([]byte, error) {
 	type noMethod BeanstalkPlatformUpdate
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BeanstalkPlatformUpdate) UnmarshalJSON(b []byte) error {
+	type noMethod BeanstalkPlatformUpdate
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BeanstalkPlatformUpdate) SetPerformAt(v *string) *BeanstalkPlatformUpdate {
@@ -1893,7 +2004,12 @@ func (o *BeanstalkPlatformUpdate) SetUpdateLevel(v *string) *BeanstalkPlatformUp
 func (o BeanstalkDeploymentPreferences) MarshalJSON() ([]byte, error) {
 	type noMethod BeanstalkDeploymentPreferences
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BeanstalkDeploymentPreferences) UnmarshalJSON(b []byte) error {
+	type noMethod BeanstalkDeploymentPreferences
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BeanstalkDeploymentPreferences) SetAutomaticRoll(v *bool) *BeanstalkDeploymentPreferences {
@@ -1931,7 +2047,12 @@ func (o *BeanstalkDeploymentPreferences) SetStrategy(v *BeanstalkDeploymentStrat
 func (o BeanstalkDeploymentStrategy) MarshalJSON() ([]byte, error) {
 	type noMethod BeanstalkDeploymentStrategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BeanstalkDeploymentStrategy) UnmarshalJSON(b []byte) error {
+	type noMethod BeanstalkDeploymentStrategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BeanstalkDeploymentStrategy) SetAction(v *string) *BeanstalkDeploymentStrategy {
@@ -1955,7 +2076,12 @@ func (o *BeanstalkDeploymentStrategy) SetShouldDrainInstances(v *bool) *Beanstal
 func (o EC2ContainerServiceIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod EC2ContainerServiceIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *EC2ContainerServiceIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod EC2ContainerServiceIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *EC2ContainerServiceIntegration) SetClusterName(v *string) *EC2ContainerServiceIntegration {
@@ -1968,7 +2094,12 @@ func (o *EC2ContainerServiceIntegration) SetClusterName(v *string) *EC2Container
 func (o AutoScaleECS) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleECS
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleECS) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleECS
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *EC2ContainerServiceIntegration) SetAutoScale(v *AutoScaleECS) *EC2ContainerServiceIntegration {
@@ -1999,7 +2130,12 @@ func (o *AutoScaleECS) SetShouldScaleDownNonServiceTasks(v *bool) *AutoScaleECS
 func (o DockerSwarmIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod DockerSwarmIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *DockerSwarmIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod DockerSwarmIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *DockerSwarmIntegration) SetMasterHost(v *string) *DockerSwarmIntegration {
@@ -2026,7 +2162,12 @@ func (o *DockerSwarmIntegration) SetAutoScale(v *AutoScaleDockerSwarm) *DockerSw
 func (o AutoScaleDockerSwarm) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleDockerSwarm
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleDockerSwarm) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleDockerSwarm
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // endregion
@@ -2036,7 +2177,12 @@ func (o AutoScaleDockerSwarm) MarshalJSON() ([]byte, error) {
 func (o Route53Integration) MarshalJSON() ([]byte, error) {
 	type noMethod Route53Integration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Route53Integration) UnmarshalJSON(b []byte) error {
+	type noMethod Route53Integration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Route53Integration) SetDomains(v []*Domain) *Route53Integration {
@@ -2053,7 +2199,12 @@ func (o *Route53Integration) SetDomains(v []*Domain) *Route53Integration {
 func (o Domain) MarshalJSON() ([]byte, error) {
 	type noMethod Domain
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Domain) UnmarshalJSON(b []byte) error {
+	type noMethod Domain
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Domain) SetHostedZoneID(v *string) *Domain {
@@ -2084,7 +2235,12 @@ func (o *Domain) SetRecordSets(v []*RecordSet) *Domain {
 func (o RecordSet) MarshalJSON() ([]byte, error) {
 	type noMethod RecordSet
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RecordSet) UnmarshalJSON(b []byte) error {
+	type noMethod RecordSet
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RecordSet) SetUsePublicIP(v *bool) *RecordSet {
@@ -2108,7 +2264,12 @@ func (o *RecordSet) SetName(v *string) *RecordSet {
 func (o AutoScale) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScale
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScale) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScale
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScale) SetIsEnabled(v *bool) *AutoScale {
@@ -2153,7 +2314,12 @@ func (o *AutoScale) SetDown(v *AutoScaleDown) *AutoScale {
 func (o AutoScaleHeadroom) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleHeadroom
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleHeadroom) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleHeadroom
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleHeadroom) SetCPUPerUnit(v *int) *AutoScaleHeadroom {
@@ -2191,7 +2357,12 @@ func (o *AutoScaleHeadroom) SetNumOfUnits(v *int) *AutoScaleHeadroom {
 func (o AutoScaleDown) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleDown
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleDown) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleDown
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleDown) SetEvaluationPeriods(v *int) *AutoScaleDown {
@@ -2215,7 +2386,12 @@ func (o *AutoScaleDown) SetMaxScaleDownPercentage(v *int) *AutoScaleDown {
 func (o AutoScaleConstraint) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleConstraint
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleConstraint) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleConstraint
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleConstraint) SetKey(v *string) *AutoScaleConstraint {
@@ -2239,7 +2415,12 @@ func (o *AutoScaleConstraint) SetValue(v *string) *AutoScaleConstraint {
 func (o AutoScaleLabel) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleLabel
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleLabel) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleLabel
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleLabel) SetKey(v *string) *AutoScaleLabel {
@@ -2263,7 +2444,12 @@ func (o *AutoScaleLabel) SetValue(v *string) *AutoScaleLabel {
 func (o KubernetesIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod KubernetesIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *KubernetesIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod KubernetesIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *KubernetesIntegration) SetIntegrationMode(v *string) *KubernetesIntegration {
@@ -2304,7 +2490,12 @@ func (o *KubernetesIntegration) SetAutoScale(v *AutoScaleKubernetes) *Kubernetes
 func (o AutoScaleKubernetes) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleKubernetes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleKubernetes) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleKubernetes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleKubernetes) SetLabels(v []*AutoScaleLabel) *AutoScaleKubernetes {
@@ -2321,7 +2512,12 @@ func (o *AutoScaleKubernetes) SetLabels(v []*AutoScaleLabel) *AutoScaleKubernete
 func (o MesosphereIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod MesosphereIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *MesosphereIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod MesosphereIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *MesosphereIntegration) SetServer(v *string) *MesosphereIntegration {
@@ -2338,7 +2534,12 @@ func (o *MesosphereIntegration) SetServer(v *string) *MesosphereIntegration {
 func (o MultaiIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod MultaiIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *MultaiIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod MultaiIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *MultaiIntegration) SetDeploymentId(v *string) *MultaiIntegration {
@@ -2355,7 +2556,12 @@ func (o *MultaiIntegration) SetDeploymentId(v *string) *MultaiIntegration {
 func (o NomadIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod NomadIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NomadIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod NomadIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *NomadIntegration) SetMasterHost(v *string) *NomadIntegration {
@@ -2389,7 +2595,12 @@ func (o *NomadIntegration) SetAutoScale(v *AutoScaleNomad) *NomadIntegration {
 func (o AutoScaleNomad) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleNomad
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleNomad) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleNomad
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleNomad) SetConstraints(v []*AutoScaleConstraint) *AutoScaleNomad {
@@ -2406,7 +2617,12 @@ func (o *AutoScaleNomad) SetConstraints(v []*AutoScaleConstraint) *AutoScaleNoma
 func (o ChefIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod ChefIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ChefIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod ChefIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ChefIntegration) SetServer(v *string) *ChefIntegration {
@@ -2451,7 +2667,12 @@ func (o *ChefIntegration) SetVersion(v *string) *ChefIntegration {
 func (o GitlabIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod GitlabIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *GitlabIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod GitlabIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *GitlabIntegration) SetRunner(v *GitlabRunner) *GitlabIntegration {
@@ -2464,7 +2685,12 @@ func (o *GitlabIntegration) SetRunner(v *GitlabRunner) *GitlabIntegration {
 func (o GitlabRunner) MarshalJSON() ([]byte, error) {
 	type noMethod GitlabRunner
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *GitlabRunner) UnmarshalJSON(b []byte) error {
+	type noMethod GitlabRunner
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *GitlabRunner) SetIsEnabled(v *bool) *GitlabRunner {
@@ -2481,7 +2707,12 @@ func (o *GitlabRunner) SetIsEnabled(v *bool) *GitlabRunner {
 func (o Scheduling) MarshalJSON() ([]byte, error) {
 	type noMethod Scheduling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scheduling) UnmarshalJSON(b []byte) error {
+	type noMethod Scheduling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
@@ -2498,7 +2729,12 @@ func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
 func (o Task) MarshalJSON() ([]byte, error) {
 	type noMethod Task
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Task) UnmarshalJSON(b []byte) error {
+	type noMethod Task
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Task) SetIsEnabled(v *bool) *Task {
@@ -2613,7 +2849,12 @@ func (o *Task) SetAdjustmentPercentage(v *int) *Task {
 func (o Scaling) MarshalJSON() ([]byte, error) {
 	type noMethod Scaling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scaling) UnmarshalJSON(b []byte) error {
+	type noMethod Scaling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scaling) SetUp(v []*ScalingPolicy) *Scaling {
@@ -2644,7 +2885,12 @@ func (o *Scaling) SetTarget(v []*ScalingPolicy) *Scaling {
 func (o ScalingPolicy) MarshalJSON() ([]byte, error) {
 	type noMethod ScalingPolicy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ScalingPolicy) UnmarshalJSON(b []byte) error {
+	type noMethod ScalingPolicy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ScalingPolicy) SetPolicyName(v *string) *ScalingPolicy {
@@ -2787,7 +3033,12 @@ func (o *ScalingPolicy) SetIsEnabled(v *bool) *ScalingPolicy {
 func (o Action) MarshalJSON() ([]byte, error) {
 	type noMethod Action
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Action) UnmarshalJSON(b []byte) error {
+	type noMethod Action
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Action) SetType(v *string) *Action {
@@ -2846,7 +3097,12 @@ func (o *Action) SetTarget(v *string) *Action {
 func (o Dimension) MarshalJSON() ([]byte, error) {
 	type noMethod Dimension
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Dimension) UnmarshalJSON(b []byte) error {
+	type noMethod Dimension
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Dimension) SetName(v *string) *Dimension {
@@ -2870,7 +3126,12 @@ func (o *Dimension) SetValue(v *string) *Dimension {
 func (o *Predictive) MarshalJSON() ([]byte, error) {
 	type noMethod Predictive
 	raw := noMethod(*o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Predictive) UnmarshalJSON(b []byte) error {
+	type noMethod Predictive
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Predictive) SetMode(v *string) *Predictive {
@@ -2887,7 +3148,12 @@ func (o *Predictive) SetMode(v *string) *Predictive {
 func (o Strategy) MarshalJSON() ([]byte, error) {
 	type noMethod Strategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Strategy) UnmarshalJSON(b []byte) error {
+	type noMethod Strategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Strategy) SetRisk(v *float64) *Strategy {
@@ -2981,7 +3247,12 @@ func (o *Strategy) SetScalingStrategy(v *ScalingStrategy) *Strategy {
 func (o ScalingStrategy) MarshalJSON() ([]byte, error) {
 	type noMethod ScalingStrategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ScalingStrategy) UnmarshalJSON(b []byte) error {
+	type noMethod ScalingStrategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ScalingStrategy) SetTerminationPolicy(v *string) *ScalingStrategy {
@@ -3005,7 +3276,12 @@ func (o *ScalingStrategy) SetTerminateAtEndOfBillingHour(v *bool) *ScalingStrate
 func (o Persistence) MarshalJSON() ([]byte, error) {
 	type noMethod Persistence
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Persistence) UnmarshalJSON(b []byte) error {
+	type noMethod Persistence
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Persistence) SetShouldPersistPrivateIP(v *bool) *Persistence {
@@ -3043,7 +3319,12 @@ func (o *Persistence) SetBlockDevicesMode(v *string) *Persistence {
 func (o RevertToSpot) MarshalJSON() ([]byte, error) {
 	type noMethod RevertToSpot
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RevertToSpot) UnmarshalJSON(b []byte) error {
+	type noMethod RevertToSpot
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RevertToSpot) SetPerformAt(v *string) *RevertToSpot {
@@ -3067,7 +3348,12 @@ func (o *RevertToSpot) SetTimeWindows(v []string) *RevertToSpot {
 func (o Signal) MarshalJSON() ([]byte, error) {
 	type noMethod Signal
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Signal) UnmarshalJSON(b []byte) error {
+	type noMethod Signal
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Signal) SetName(v *string) *Signal {
@@ -3091,7 +3377,12 @@ func (o *Signal) SetTimeout(v *int) *Signal {
 func (o Capacity) MarshalJSON() ([]byte, error) {
 	type noMethod Capacity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Capacity) UnmarshalJSON(b []byte) error {
+	type noMethod Capacity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Capacity) SetMinimum(v *int) *Capacity {
@@ -3129,7 +3420,12 @@ func (o *Capacity) SetUnit(v *string) *Capacity {
 func (o Compute) MarshalJSON() ([]byte, error) {
 	type noMethod Compute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Compute) UnmarshalJSON(b []byte) error {
+	type noMethod Compute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Compute) SetProduct(v *string) *Compute {
@@ -3204,7 +3500,12 @@ func (o *Compute) SetSubnetIDs(v []string) *Compute {
 func (o EBSVolume) MarshalJSON() ([]byte, error) {
 	type noMethod EBSVolume
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *EBSVolume) UnmarshalJSON(b []byte) error {
+	type noMethod EBSVolume
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *EBSVolume) SetDeviceName(v *string) *EBSVolume {
@@ -3228,7 +3529,12 @@ func (o *EBSVolume) SetVolumeIDs(v []string) *EBSVolume {
 func (o InstanceTypes) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceTypes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceTypes) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceTypes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceTypes) SetOnDemand(v *string) *InstanceTypes {
@@ -3266,7 +3572,12 @@ func (o *InstanceTypes) SetWeights(v []*InstanceTypeWeight) *InstanceTypes {
 func (o InstanceTypeWeight) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceTypeWeight
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceTypeWeight) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceTypeWeight
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceTypeWeight) SetInstanceType(v *string) *InstanceTypeWeight {
@@ -3290,7 +3601,12 @@ func (o *InstanceTypeWeight) SetWeight(v *int) *InstanceTypeWeight {
 func (o AvailabilityZone) MarshalJSON() ([]byte, error) {
 	type noMethod AvailabilityZone
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AvailabilityZone) UnmarshalJSON(b []byte) error {
+	type noMethod AvailabilityZone
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AvailabilityZone) SetName(v *string) *AvailabilityZone {
@@ -3321,7 +3637,12 @@ func (o *AvailabilityZone) SetPlacementGroupName(v *string) *AvailabilityZone {
 func (o LaunchSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod LaunchSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LaunchSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod LaunchSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LaunchSpecification) SetLoadBalancerNames(v []string) *LaunchSpecification {
@@ -3457,7 +3778,12 @@ func (o *LaunchSpecification) SetTags(v []*Tag) *LaunchSpecification {
 func (o LoadBalancersConfig) MarshalJSON() ([]byte, error) {
 	type noMethod LoadBalancersConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LoadBalancersConfig) UnmarshalJSON(b []byte) error {
+	type noMethod LoadBalancersConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LoadBalancersConfig) SetLoadBalancers(v []*LoadBalancer) *LoadBalancersConfig {
@@ -3474,7 +3800,12 @@ func (o *LoadBalancersConfig) SetLoadBalancers(v []*LoadBalancer) *LoadBalancers
 func (o LoadBalancer) MarshalJSON() ([]byte, error) {
 	type noMethod LoadBalancer
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LoadBalancer) UnmarshalJSON(b []byte) error {
+	type noMethod LoadBalancer
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LoadBalancer) SetName(v *string) *LoadBalancer {
@@ -3533,7 +3864,12 @@ func (o *LoadBalancer) SetAutoWeight(v *bool) *LoadBalancer {
 func (o NetworkInterface) MarshalJSON() ([]byte, error) {
 	type noMethod NetworkInterface
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NetworkInterface) UnmarshalJSON(b []byte) error {
+	type noMethod NetworkInterface
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *NetworkInterface) SetId(v *string) *NetworkInterface {
@@ -3613,7 +3949,12 @@ func (o *NetworkInterface) SetSubnetId(v *string) *NetworkInterface {
 func (o BlockDeviceMapping) MarshalJSON() ([]byte, error) {
 	type noMethod BlockDeviceMapping
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BlockDeviceMapping) UnmarshalJSON(b []byte) error {
+	type noMethod BlockDeviceMapping
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BlockDeviceMapping) SetDeviceName(v *string) *BlockDeviceMapping {
@@ -3644,7 +3985,12 @@ func (o *BlockDeviceMapping) SetEBS(v *EBS) *BlockDeviceMapping {
 func (o EBS) MarshalJSON() ([]byte, error) {
 	type noMethod EBS
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *EBS) UnmarshalJSON(b []byte) error {
+	type noMethod EBS
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *EBS) SetDeleteOnTermination(v *bool) *EBS {
@@ -3703,7 +4049,12 @@ func (o *EBS) SetIOPS(v *int) *EBS {
 func (o IAMInstanceProfile) MarshalJSON() ([]byte, error) {
 	type noMethod IAMInstanceProfile
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *IAMInstanceProfile) UnmarshalJSON(b []byte) error {
+	type noMethod IAMInstanceProfile
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *IAMInstanceProfile) SetName(v *string) *IAMInstanceProfile {
@@ -3727,7 +4078,12 @@ func (o *IAMInstanceProfile) SetArn(v *string) *IAMInstanceProfile {
 func (o CreditSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod CreditSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *CreditSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod CreditSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *CreditSpecification) SetCPUCredits(v *string) *CreditSpecification {
@@ -3744,7 +4100,12 @@ func (o *CreditSpecification) SetCPUCredits(v *string) *CreditSpecification {
 func (o RollStrategy) MarshalJSON() ([]byte, error) {
 	type noMethod RollStrategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RollStrategy) UnmarshalJSON(b []byte) error {
+	type noMethod RollStrategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RollStrategy) SetAction(v *string) *RollStrategy {
@@ -3768,7 +4129,12 @@ func (o *RollStrategy) SetShouldDrainInstances(v *bool) *RollStrategy {
 func (o CodeDeployIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod CodeDeployIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *CodeDeployIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod CodeDeployIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *CodeDeployIntegration) SetDeploymentGroups(v []*DeploymentGroup) *CodeDeployIntegration {
@@ -3799,7 +4165,12 @@ func (o *CodeDeployIntegration) SetTerminateInstanceOnFailure(v *bool) *CodeDepl
 func (o DeploymentGroup) MarshalJSON() ([]byte, error) {
 	type noMethod DeploymentGroup
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *DeploymentGroup) UnmarshalJSON(b []byte) error {
+	type noMethod DeploymentGroup
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *DeploymentGroup) SetApplicationName(v *string) *DeploymentGroup {
@@ -3823,7 +4194,12 @@ func (o *DeploymentGroup) SetDeploymentGroupName(v *string) *DeploymentGroup {
 func (o OpsWorksIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod OpsWorksIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *OpsWorksIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod OpsWorksIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *OpsWorksIntegration) SetLayerId(v *string) *OpsWorksIntegration {
@@ -3926,14 +4302,16 @@ func (s *ServiceOp) Scale(ctx context.Context, input *ScaleGroupInput) (*ScaleGr
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.NotIdempotent = true
 
 	if input.Adjustment != nil {
 		r.Params.Set("adjustment", strconv.Itoa(*input.Adjustment))
 	}
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
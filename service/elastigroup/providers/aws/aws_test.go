@@ -0,0 +1,320 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/diff"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupUnknownFields(t *testing.T) {
+	in := `{
+		"id": "sig-1",
+		"name": "foo",
+		"newSetting": {"enabled": true},
+		"compute": {
+			"product": "Linux/UNIX",
+			"launchSpecification": {"imageId": "ami-1", "newNested": [1, 2]}
+		}
+	}`
+
+	group, err := groupFromJSON([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Read-modify-Update.
+	group.SetName(spotinst.String("bar"))
+	group.Compute.LaunchSpecification.SetImageId(nil)
+
+	b, err := json.Marshal(group)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"id": "sig-1",
+		"name": "bar",
+		"newSetting": {"enabled": true},
+		"compute": {
+			"product": "Linux/UNIX",
+			"launchSpecification": {"imageId": null, "newNested": [1, 2]}
+		}
+	}`, string(b))
+}
+
+func TestGroupUnknownFieldsOptOut(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	var updates []string
+	srv.Handle(http.MethodPut, "/aws/ec2/group/{groupId}",
+		func(req *http.Request, body []byte) ([]interface{}, error) {
+			updates = append(updates, string(body))
+			return []interface{}{map[string]interface{}{"id": "sig-1"}}, nil
+		})
+
+	group, err := groupFromJSON([]byte(`{"id": "sig-1", "name": "foo", "newSetting": 1, "compute": {"newNested": 2}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The option is per client: others still send the unknown fields back.
+	svc := New(srv.Session(), new(spotinst.Config).WithPreserveUnknownFields(false))
+	if _, err := svc.Update(context.Background(), &UpdateGroupInput{Group: group}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(srv.Session()).Update(context.Background(), &UpdateGroupInput{Group: group}); err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, updates, 2) {
+		assert.JSONEq(t, `{"group": {"name": "foo", "compute": {}}}`, updates[0])
+		assert.JSONEq(t, `{"group": {"name": "foo", "newSetting": 1, "compute": {"newNested": 2}}}`, updates[1])
+	}
+}
+
+func TestAutoScaleEmbeddingUnknownFields(t *testing.T) {
+	in := `{"isEnabled": true, "shouldScaleDownNonServiceTasks": true, "attributes": [{"key": "k"}], "new": 1}`
+
+	var autoScale AutoScaleECS
+	if err := json.Unmarshal([]byte(in), &autoScale); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, true, spotinst.BoolValue(autoScale.IsEnabled))
+	assert.Equal(t, true, spotinst.BoolValue(autoScale.ShouldScaleDownNonServiceTasks))
+	assert.Len(t, autoScale.Attributes, 1)
+
+	b, err := json.Marshal(&autoScale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, in, string(b))
+}
+
+func TestGroupDeepCopy(t *testing.T) {
+	group := &Group{}
+	group.SetName(spotinst.String("foo"))
+	group.SetDescription(nil)
+	group.SetCompute(&Compute{})
+	group.Compute.SetProduct(nil)
+
+	clone := group.DeepCopy()
+
+	// Update the clone and verify that the group is left untouched.
+	clone.SetName(spotinst.String("bar"))
+	clone.SetRegion(nil)
+	clone.Compute.SetSubnetIDs(nil)
+
+	b, err := json.Marshal(group)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"name": "foo",
+		"description": null,
+		"compute": {"product": null}
+	}`, string(b))
+
+	b, err = json.Marshal(clone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"name": "bar",
+		"description": null,
+		"region": null,
+		"compute": {"product": null, "subnetIds": null}
+	}`, string(b))
+
+	assert.Nil(t, (*Group)(nil).DeepCopy())
+}
+
+func TestGroupValidate(t *testing.T) {
+	group := &Group{
+		Capacity: &Capacity{Minimum: spotinst.Int(3), Maximum: spotinst.Int(2)},
+		Strategy: &Strategy{Risk: spotinst.Float64(120), OnDemandCount: spotinst.Int(1)},
+		Compute: &Compute{
+			InstanceTypes: &InstanceTypes{Spot: []string{}},
+		},
+		Scaling: &Scaling{
+			Up: []*ScalingPolicy{{PolicyName: spotinst.String("up"), Namespace: spotinst.String("AWS/EC2")}},
+		},
+	}
+
+	err := group.Validate()
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+
+	var errs validation.Errors
+	if assert.True(t, errors.As(err, &errs)) {
+		var paths []string
+		for _, e := range errs {
+			paths = append(paths, e.Path)
+		}
+		assert.Equal(t, []string{
+			"capacity.minimum",
+			"strategy.risk",
+			"strategy.onDemandCount",
+			"compute.instanceTypes.spot",
+			"scaling.up[0].metricName",
+		}, paths)
+	}
+
+	// Partial groups, e.g. updates, are valid.
+	assert.NoError(t, (&Group{Capacity: &Capacity{Target: spotinst.Int(2)}}).Validate())
+	assert.NoError(t, (*Group)(nil).Validate())
+
+	// Creates require the mandatory fields.
+	err = (&Group{Capacity: &Capacity{Target: spotinst.Int(2)}}).ValidateCreate()
+	assert.EqualError(t, err, "spotinst: invalid resource: name: must be set; compute: must be set")
+
+	group = &Group{
+		Name:     spotinst.String("foo"),
+		Capacity: &Capacity{Target: spotinst.Int(2)},
+		Compute: &Compute{
+			Product:       spotinst.String("Linux/UNIX"),
+			InstanceTypes: &InstanceTypes{OnDemand: spotinst.String("m5.large")},
+		},
+	}
+	assert.NoError(t, group.Validate())
+	assert.EqualError(t, group.ValidateCreate(), "spotinst: invalid resource: compute.instanceTypes.spot: must be set")
+
+	group.Compute.InstanceTypes.SetSpot([]string{"m5.large"})
+	assert.NoError(t, group.ValidateCreate())
+}
+
+func TestCreateValidation(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	invalid := &Group{Capacity: &Capacity{Minimum: spotinst.Int(3), Maximum: spotinst.Int(2)}}
+
+	// Disabled by default.
+	svc := New(srv.Session())
+	_, err := svc.Create(context.Background(), &CreateGroupInput{Group: invalid})
+	assert.NoError(t, err)
+
+	svc = New(srv.Session(), new(spotinst.Config).WithValidation(true))
+	_, err = svc.Create(context.Background(), &CreateGroupInput{Group: invalid})
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"name: must be set; compute: must be set; "+
+		"capacity.minimum: must not be greater than maximum (3 > 2)")
+}
+
+func TestApply(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	var rolls int
+	srv.Handle(http.MethodPut, "/aws/ec2/group/{groupId}/roll",
+		func(req *http.Request, body []byte) ([]interface{}, error) {
+			rolls++
+			return []interface{}{map[string]interface{}{"id": "sbgd-1"}}, nil
+		})
+
+	svc := New(srv.Session())
+	ctx := context.Background()
+
+	desired := func(imageID string) *Group {
+		group := new(Group)
+		group.SetName(spotinst.String("foo"))
+		group.SetCapacity(&Capacity{Minimum: spotinst.Int(1), Maximum: spotinst.Int(3)})
+		group.SetCompute(&Compute{LaunchSpecification: &LaunchSpecification{ImageID: spotinst.String(imageID)}})
+		return group
+	}
+
+	out, err := Apply(ctx, svc, &ApplyGroupInput{Group: desired("ami-1")})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionCreated, out.Action)
+	assert.Len(t, out.Changes, 4)
+	id := spotinst.StringValue(out.Group.ID)
+	assert.NotEmpty(t, id)
+
+	// The API fills in defaults for the fields that the spec omits, which are
+	// left as they are.
+	defaults := &Group{
+		ID:       spotinst.String(id),
+		Capacity: &Capacity{Unit: spotinst.String("instance")},
+		Strategy: &Strategy{Risk: spotinst.Float64(100)},
+	}
+	if _, err := svc.Update(ctx, &UpdateGroupInput{Group: defaults}); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err = Apply(ctx, svc, &ApplyGroupInput{Group: desired("ami-1")})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionUnchanged, out.Action)
+	assert.Empty(t, out.Changes)
+	assert.Equal(t, id, spotinst.StringValue(out.Group.ID))
+
+	roll := &RollGroupInput{BatchSizePercentage: spotinst.Int(50)}
+
+	// Plan only.
+	out, err = Apply(ctx, svc, &ApplyGroupInput{Group: desired("ami-2"), PlanOnly: spotinst.Bool(true), Roll: roll})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionUpdated, out.Action)
+	assert.Equal(t, "~ compute.launchSpecification.imageId: \"ami-1\" => \"ami-2\"", out.Changes.String())
+	assert.Nil(t, out.Roll)
+	assert.Equal(t, 0, rolls)
+	stored, _ := srv.Get("/aws/ec2/group", id)
+	assert.Equal(t, "ami-1", stored["compute"].(map[string]interface{})["launchSpecification"].(map[string]interface{})["imageId"])
+
+	out, err = Apply(ctx, svc, &ApplyGroupInput{Group: desired("ami-2"), Roll: roll})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionUpdated, out.Action)
+	assert.Equal(t, "ami-2", spotinst.StringValue(out.Group.Compute.LaunchSpecification.ImageID))
+	assert.NotNil(t, out.Roll)
+	assert.Equal(t, 1, rolls)
+	assert.Equal(t, 100.0, spotinst.Float64Value(out.Group.Strategy.Risk))
+	assert.Equal(t, "instance", spotinst.StringValue(out.Group.Capacity.Unit))
+
+	// No roll unless the launch specification changed.
+	group := desired("ami-2")
+	group.Capacity.SetMaximum(spotinst.Int(4))
+	out, err = Apply(ctx, svc, &ApplyGroupInput{Group: group, Roll: roll})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionUpdated, out.Action)
+	assert.Nil(t, out.Roll)
+	assert.Equal(t, 1, rolls)
+
+	// Lookup by ID.
+	group.SetName(spotinst.String("bar"))
+	group.ID = spotinst.String(id)
+	out, err = Apply(ctx, svc, &ApplyGroupInput{Group: group, PlanOnly: spotinst.Bool(true)})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "~ name: \"foo\" => \"bar\"", out.Changes.String())
+
+	// A group that no longer exists is created.
+	out, err = Apply(ctx, svc, &ApplyGroupInput{Group: &Group{ID: spotinst.String("sig-missing"), Name: spotinst.String("baz")}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionCreated, out.Action)
+	assert.NotEqual(t, "sig-missing", spotinst.StringValue(out.Group.ID))
+	assert.Equal(t, "baz", spotinst.StringValue(out.Group.Name))
+
+	if _, err := srv.Put("/aws/ec2/group", desired("ami-1")); err != nil {
+		t.Fatal(err)
+	}
+	_, err = Apply(ctx, svc, &ApplyGroupInput{Group: desired("ami-1")})
+	assert.EqualError(t, err, "spotinst: more than one group named \"foo\"")
+}
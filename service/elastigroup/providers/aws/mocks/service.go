@@ -0,0 +1,556 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of aws.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of aws.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(context.Context, *aws.ListGroupsInput) (*aws.ListGroupsOutput, error)
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(context.Context, *aws.CreateGroupInput) (*aws.CreateGroupOutput, error)
+
+	// ReadFunc, if set, is called by Read.
+	ReadFunc func(context.Context, *aws.ReadGroupInput) (*aws.ReadGroupOutput, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(context.Context, *aws.UpdateGroupInput) (*aws.UpdateGroupOutput, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(context.Context, *aws.DeleteGroupInput) (*aws.DeleteGroupOutput, error)
+
+	// StatusFunc, if set, is called by Status.
+	StatusFunc func(context.Context, *aws.StatusGroupInput) (*aws.StatusGroupOutput, error)
+
+	// DeploymentStatusFunc, if set, is called by DeploymentStatus.
+	DeploymentStatusFunc func(context.Context, *aws.DeploymentStatusInput) (*aws.RollGroupOutput, error)
+
+	// DeploymentStatusECSFunc, if set, is called by DeploymentStatusECS.
+	DeploymentStatusECSFunc func(context.Context, *aws.DeploymentStatusInput) (*aws.RollGroupOutput, error)
+
+	// StopDeploymentFunc, if set, is called by StopDeployment.
+	StopDeploymentFunc func(context.Context, *aws.StopDeploymentInput) (*aws.StopDeploymentOutput, error)
+
+	// DetachFunc, if set, is called by Detach.
+	DetachFunc func(context.Context, *aws.DetachGroupInput) (*aws.DetachGroupOutput, error)
+
+	// RollFunc, if set, is called by Roll.
+	RollFunc func(context.Context, *aws.RollGroupInput) (*aws.RollGroupOutput, error)
+
+	// RollECSFunc, if set, is called by RollECS.
+	RollECSFunc func(context.Context, *aws.RollECSGroupInput) (*aws.RollGroupOutput, error)
+
+	// ScaleFunc, if set, is called by Scale.
+	ScaleFunc func(context.Context, *aws.ScaleGroupInput) (*aws.ScaleGroupOutput, error)
+
+	// GetInstanceHealthinessFunc, if set, is called by GetInstanceHealthiness.
+	GetInstanceHealthinessFunc func(context.Context, *aws.GetInstanceHealthinessInput) (*aws.GetInstanceHealthinessOutput, error)
+
+	// GetGroupEventsFunc, if set, is called by GetGroupEvents.
+	GetGroupEventsFunc func(context.Context, *aws.GetGroupEventsInput) (*aws.GetGroupEventsOutput, error)
+
+	// ImportBeanstalkEnvFunc, if set, is called by ImportBeanstalkEnv.
+	ImportBeanstalkEnvFunc func(context.Context, *aws.ImportBeanstalkInput) (*aws.ImportBeanstalkOutput, error)
+
+	// StartBeanstalkMaintenanceFunc, if set, is called by StartBeanstalkMaintenance.
+	StartBeanstalkMaintenanceFunc func(context.Context, *aws.BeanstalkMaintenanceInput) (*aws.BeanstalkMaintenanceOutput, error)
+
+	// FinishBeanstalkMaintenanceFunc, if set, is called by FinishBeanstalkMaintenance.
+	FinishBeanstalkMaintenanceFunc func(context.Context, *aws.BeanstalkMaintenanceInput) (*aws.BeanstalkMaintenanceOutput, error)
+
+	// GetBeanstalkMaintenanceStatusFunc, if set, is called by GetBeanstalkMaintenanceStatus.
+	GetBeanstalkMaintenanceStatusFunc func(context.Context, *aws.BeanstalkMaintenanceInput) (*string, error)
+}
+
+var _ aws.Service = (*Service)(nil)
+
+// List records the call and returns the configured results.
+func (m *Service) List(ctx context.Context, input *aws.ListGroupsInput) (*aws.ListGroupsOutput, error) {
+	m.Record("List", ctx, input)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ListGroupsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("List", 2); ok {
+		if r0, ok = rs[0].(*aws.ListGroupsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: List returns *aws.ListGroupsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: List returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ListGroupsOutput)
+	}
+
+	return r0, r1
+}
+
+// Create records the call and returns the configured results.
+func (m *Service) Create(ctx context.Context, input *aws.CreateGroupInput) (*aws.CreateGroupOutput, error) {
+	m.Record("Create", ctx, input)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.CreateGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Create", 2); ok {
+		if r0, ok = rs[0].(*aws.CreateGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Create returns *aws.CreateGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Create returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.CreateGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Read records the call and returns the configured results.
+func (m *Service) Read(ctx context.Context, input *aws.ReadGroupInput) (*aws.ReadGroupOutput, error) {
+	m.Record("Read", ctx, input)
+	if m.ReadFunc != nil {
+		return m.ReadFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Read", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Read returns *aws.ReadGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Read returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Update records the call and returns the configured results.
+func (m *Service) Update(ctx context.Context, input *aws.UpdateGroupInput) (*aws.UpdateGroupOutput, error) {
+	m.Record("Update", ctx, input)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.UpdateGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Update", 2); ok {
+		if r0, ok = rs[0].(*aws.UpdateGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Update returns *aws.UpdateGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Update returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.UpdateGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Delete records the call and returns the configured results.
+func (m *Service) Delete(ctx context.Context, input *aws.DeleteGroupInput) (*aws.DeleteGroupOutput, error) {
+	m.Record("Delete", ctx, input)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DeleteGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Delete", 2); ok {
+		if r0, ok = rs[0].(*aws.DeleteGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Delete returns *aws.DeleteGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Delete returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DeleteGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Status records the call and returns the configured results.
+func (m *Service) Status(ctx context.Context, input *aws.StatusGroupInput) (*aws.StatusGroupOutput, error) {
+	m.Record("Status", ctx, input)
+	if m.StatusFunc != nil {
+		return m.StatusFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.StatusGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Status", 2); ok {
+		if r0, ok = rs[0].(*aws.StatusGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Status returns *aws.StatusGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Status returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.StatusGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// DeploymentStatus records the call and returns the configured results.
+func (m *Service) DeploymentStatus(ctx context.Context, input *aws.DeploymentStatusInput) (*aws.RollGroupOutput, error) {
+	m.Record("DeploymentStatus", ctx, input)
+	if m.DeploymentStatusFunc != nil {
+		return m.DeploymentStatusFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.RollGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeploymentStatus", 2); ok {
+		if r0, ok = rs[0].(*aws.RollGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeploymentStatus returns *aws.RollGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeploymentStatus returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.RollGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// DeploymentStatusECS records the call and returns the configured results.
+func (m *Service) DeploymentStatusECS(ctx context.Context, input *aws.DeploymentStatusInput) (*aws.RollGroupOutput, error) {
+	m.Record("DeploymentStatusECS", ctx, input)
+	if m.DeploymentStatusECSFunc != nil {
+		return m.DeploymentStatusECSFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.RollGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeploymentStatusECS", 2); ok {
+		if r0, ok = rs[0].(*aws.RollGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeploymentStatusECS returns *aws.RollGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeploymentStatusECS returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.RollGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// StopDeployment records the call and returns the configured results.
+func (m *Service) StopDeployment(ctx context.Context, input *aws.StopDeploymentInput) (*aws.StopDeploymentOutput, error) {
+	m.Record("StopDeployment", ctx, input)
+	if m.StopDeploymentFunc != nil {
+		return m.StopDeploymentFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.StopDeploymentOutput
+		r1 error
+	)
+	if rs, ok := m.Result("StopDeployment", 2); ok {
+		if r0, ok = rs[0].(*aws.StopDeploymentOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: StopDeployment returns *aws.StopDeploymentOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: StopDeployment returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.StopDeploymentOutput)
+	}
+
+	return r0, r1
+}
+
+// Detach records the call and returns the configured results.
+func (m *Service) Detach(ctx context.Context, input *aws.DetachGroupInput) (*aws.DetachGroupOutput, error) {
+	m.Record("Detach", ctx, input)
+	if m.DetachFunc != nil {
+		return m.DetachFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DetachGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Detach", 2); ok {
+		if r0, ok = rs[0].(*aws.DetachGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Detach returns *aws.DetachGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Detach returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DetachGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Roll records the call and returns the configured results.
+func (m *Service) Roll(ctx context.Context, input *aws.RollGroupInput) (*aws.RollGroupOutput, error) {
+	m.Record("Roll", ctx, input)
+	if m.RollFunc != nil {
+		return m.RollFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.RollGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Roll", 2); ok {
+		if r0, ok = rs[0].(*aws.RollGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Roll returns *aws.RollGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Roll returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.RollGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// RollECS records the call and returns the configured results.
+func (m *Service) RollECS(ctx context.Context, input *aws.RollECSGroupInput) (*aws.RollGroupOutput, error) {
+	m.Record("RollECS", ctx, input)
+	if m.RollECSFunc != nil {
+		return m.RollECSFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.RollGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("RollECS", 2); ok {
+		if r0, ok = rs[0].(*aws.RollGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: RollECS returns *aws.RollGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: RollECS returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.RollGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Scale records the call and returns the configured results.
+func (m *Service) Scale(ctx context.Context, input *aws.ScaleGroupInput) (*aws.ScaleGroupOutput, error) {
+	m.Record("Scale", ctx, input)
+	if m.ScaleFunc != nil {
+		return m.ScaleFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ScaleGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Scale", 2); ok {
+		if r0, ok = rs[0].(*aws.ScaleGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Scale returns *aws.ScaleGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Scale returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ScaleGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// GetInstanceHealthiness records the call and returns the configured results.
+func (m *Service) GetInstanceHealthiness(ctx context.Context, input *aws.GetInstanceHealthinessInput) (*aws.GetInstanceHealthinessOutput, error) {
+	m.Record("GetInstanceHealthiness", ctx, input)
+	if m.GetInstanceHealthinessFunc != nil {
+		return m.GetInstanceHealthinessFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.GetInstanceHealthinessOutput
+		r1 error
+	)
+	if rs, ok := m.Result("GetInstanceHealthiness", 2); ok {
+		if r0, ok = rs[0].(*aws.GetInstanceHealthinessOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: GetInstanceHealthiness returns *aws.GetInstanceHealthinessOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: GetInstanceHealthiness returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.GetInstanceHealthinessOutput)
+	}
+
+	return r0, r1
+}
+
+// GetGroupEvents records the call and returns the configured results.
+func (m *Service) GetGroupEvents(ctx context.Context, input *aws.GetGroupEventsInput) (*aws.GetGroupEventsOutput, error) {
+	m.Record("GetGroupEvents", ctx, input)
+	if m.GetGroupEventsFunc != nil {
+		return m.GetGroupEventsFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.GetGroupEventsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("GetGroupEvents", 2); ok {
+		if r0, ok = rs[0].(*aws.GetGroupEventsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: GetGroupEvents returns *aws.GetGroupEventsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: GetGroupEvents returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.GetGroupEventsOutput)
+	}
+
+	return r0, r1
+}
+
+// ImportBeanstalkEnv records the call and returns the configured results.
+func (m *Service) ImportBeanstalkEnv(ctx context.Context, input *aws.ImportBeanstalkInput) (*aws.ImportBeanstalkOutput, error) {
+	m.Record("ImportBeanstalkEnv", ctx, input)
+	if m.ImportBeanstalkEnvFunc != nil {
+		return m.ImportBeanstalkEnvFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ImportBeanstalkOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ImportBeanstalkEnv", 2); ok {
+		if r0, ok = rs[0].(*aws.ImportBeanstalkOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ImportBeanstalkEnv returns *aws.ImportBeanstalkOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ImportBeanstalkEnv returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ImportBeanstalkOutput)
+	}
+
+	return r0, r1
+}
+
+// StartBeanstalkMaintenance records the call and returns the configured results.
+func (m *Service) StartBeanstalkMaintenance(ctx context.Context, input *aws.BeanstalkMaintenanceInput) (*aws.BeanstalkMaintenanceOutput, error) {
+	m.Record("StartBeanstalkMaintenance", ctx, input)
+	if m.StartBeanstalkMaintenanceFunc != nil {
+		return m.StartBeanstalkMaintenanceFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.BeanstalkMaintenanceOutput
+		r1 error
+	)
+	if rs, ok := m.Result("StartBeanstalkMaintenance", 2); ok {
+		if r0, ok = rs[0].(*aws.BeanstalkMaintenanceOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: StartBeanstalkMaintenance returns *aws.BeanstalkMaintenanceOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: StartBeanstalkMaintenance returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.BeanstalkMaintenanceOutput)
+	}
+
+	return r0, r1
+}
+
+// FinishBeanstalkMaintenance records the call and returns the configured results.
+func (m *Service) FinishBeanstalkMaintenance(ctx context.Context, input *aws.BeanstalkMaintenanceInput) (*aws.BeanstalkMaintenanceOutput, error) {
+	m.Record("FinishBeanstalkMaintenance", ctx, input)
+	if m.FinishBeanstalkMaintenanceFunc != nil {
+		return m.FinishBeanstalkMaintenanceFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.BeanstalkMaintenanceOutput
+		r1 error
+	)
+	if rs, ok := m.Result("FinishBeanstalkMaintenance", 2); ok {
+		if r0, ok = rs[0].(*aws.BeanstalkMaintenanceOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: FinishBeanstalkMaintenance returns *aws.BeanstalkMaintenanceOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: FinishBeanstalkMaintenance returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.BeanstalkMaintenanceOutput)
+	}
+
+	return r0, r1
+}
+
+// GetBeanstalkMaintenanceStatus records the call and returns the configured results.
+func (m *Service) GetBeanstalkMaintenanceStatus(ctx context.Context, input *aws.BeanstalkMaintenanceInput) (*string, error) {
+	m.Record("GetBeanstalkMaintenanceStatus", ctx, input)
+	if m.GetBeanstalkMaintenanceStatusFunc != nil {
+		return m.GetBeanstalkMaintenanceStatusFunc(ctx, input)
+	}
+
+	var (
+		r0 *string
+		r1 error
+	)
+	if rs, ok := m.Result("GetBeanstalkMaintenanceStatus", 2); ok {
+		if r0, ok = rs[0].(*string); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: GetBeanstalkMaintenanceStatus returns *string as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: GetBeanstalkMaintenanceStatus returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(string)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// InstanceHealthStatusHealthy is the health status of healthy instances, as
+// reported by GetInstanceHealthiness.
+const InstanceHealthStatusHealthy = "HEALTHY"
+
+// WaitUntilRollFinished polls the DeploymentStatus of svc until the roll
+// reaches a terminal state. It returns the final roll status, or a
+// *waiter.RollError if the roll failed or was stopped.
+func WaitUntilRollFinished(ctx context.Context, svc Service, groupID, rollID string,
+	opts *waiter.Options) (*RollGroupStatus, error) {
+	return waitUntilRollFinished(ctx, groupID, rollID, opts, svc.DeploymentStatus)
+}
+
+// WaitUntilECSRollFinished polls the DeploymentStatusECS of svc until the ECS
+// cluster roll reaches a terminal state. It returns the final roll status, or
+// a *waiter.RollError if the roll failed or was stopped.
+func WaitUntilECSRollFinished(ctx context.Context, svc Service, groupID, rollID string,
+	opts *waiter.Options) (*RollGroupStatus, error) {
+	return waitUntilRollFinished(ctx, groupID, rollID, opts, svc.DeploymentStatusECS)
+}
+
+func waitUntilRollFinished(ctx context.Context, groupID, rollID string, opts *waiter.Options,
+	fn func(context.Context, *DeploymentStatusInput) (*RollGroupOutput, error)) (*RollGroupStatus, error) {
+	var status *RollGroupStatus
+
+	err := waiter.Poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		output, err := fn(ctx, &DeploymentStatusInput{
+			GroupID: spotinst.String(groupID),
+			RollID:  spotinst.String(rollID),
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(output.RollGroupStatus) == 0 {
+			return false, fmt.Errorf("spotinst: roll %q of group %q not found", rollID, groupID)
+		}
+
+		status = output.RollGroupStatus[0]
+		progress := waiter.Progress{
+			ID:    spotinst.StringValue(status.RollID),
+			State: spotinst.StringValue(status.RollStatus),
+		}
+		if status.Progress != nil {
+			progress.Value = spotinst.IntValue(status.Progress.Value)
+			progress.Unit = spotinst.StringValue(status.Progress.Unit)
+		}
+		opts.Report(progress)
+
+		return waiter.RollDone(groupID, rollID, progress.State)
+	})
+
+	return status, err
+}
+
+// WaitUntilStable polls the group through svc until it runs as many instances
+// as its target capacity, and all of them are reported HEALTHY by
+// GetInstanceHealthiness. It returns the last observed stability, and a
+// *waiter.StabilityError describing what is still missing if the group did not
+// become stable in time.
+func WaitUntilStable(ctx context.Context, svc Service, groupID string,
+	opts *waiter.Options) (*waiter.Stability, error) {
+	return waiter.PollStable(ctx, groupID, opts, func(ctx context.Context) (*waiter.Stability, error) {
+		return stability(ctx, svc, groupID)
+	})
+}
+
+func stability(ctx context.Context, svc Service, groupID string) (*waiter.Stability, error) {
+	group, err := svc.Read(ctx, &ReadGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := svc.Status(ctx, &StatusGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := svc.GetInstanceHealthiness(ctx, &GetInstanceHealthinessInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	healthy := make(map[string]bool, len(health.Instances))
+	for _, h := range health.Instances {
+		healthy[spotinst.StringValue(h.InstanceID)] = strings.EqualFold(
+			spotinst.StringValue(h.HealthStatus), InstanceHealthStatusHealthy)
+	}
+
+	st := new(waiter.Stability)
+	if group.Group != nil && group.Group.Capacity != nil {
+		st.Target = spotinst.IntValue(group.Group.Capacity.Target)
+	}
+
+	for _, i := range status.Instances {
+		id := spotinst.StringValue(i.ID)
+		if id == "" {
+			st.Pending = append(st.Pending, spotinst.StringValue(i.SpotRequestID))
+			continue
+		}
+
+		st.Running = append(st.Running, id)
+		if healthy[id] {
+			st.Healthy = append(st.Healthy, id)
+		} else {
+			st.Unhealthy = append(st.Unhealthy, id)
+		}
+	}
+
+	return st, nil
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+	"github.com/stretchr/testify/assert"
+)
+
+const deploymentStatusResp = `
+{
+	"response": {
+		"items": [{
+			"id": "sbgd-1",
+			"status": "%s",
+			"progress": {
+				"unit": "percentage",
+				"value": %d
+			}
+		}]
+	}
+}
+`
+
+func TestWaitUntilRollFinished(t *testing.T) {
+	cases := map[string]struct {
+		States   []string
+		Sentinel error
+	}{
+		"finished": {
+			States: []string{"STARTING", "IN_PROGRESS", "FINISHED"},
+		},
+		"failed": {
+			States:   []string{"IN_PROGRESS", "FAILED"},
+			Sentinel: waiter.ErrRollFailed,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/aws/ec2/group/sig-1/roll/sbgd-1", r.URL.Path)
+				n := atomic.AddInt32(&calls, 1) - 1
+				fmt.Fprintf(w, deploymentStatusResp, c.States[n], 50*n)
+			}))
+			defer ts.Close()
+
+			sess := session.New(spotinst.DefaultConfig().
+				WithBaseURL(ts.URL).
+				WithCredentials(credentials.NewStaticCredentials("token", "")))
+
+			var progress []int
+			status, err := WaitUntilRollFinished(context.Background(), New(sess), "sig-1", "sbgd-1",
+				&waiter.Options{
+					PollInterval: time.Millisecond,
+					OnProgress: func(p waiter.Progress) {
+						progress = append(progress, p.Value)
+					},
+				})
+
+			if c.Sentinel != nil {
+				assert.True(t, errors.Is(err, c.Sentinel))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, c.States[len(c.States)-1], spotinst.StringValue(status.RollStatus))
+			assert.Len(t, progress, len(c.States))
+		})
+	}
+}
+
+func TestWaitUntilStable(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/aws/ec2/group/sig-1":
+			atomic.AddInt32(&polls, 1)
+			fmt.Fprint(w, `{"response":{"items":[{"id":"sig-1","capacity":{"target":2}}]}}`)
+		case "/aws/ec2/group/sig-1/status":
+			fmt.Fprint(w, `{"response":{"items":[{"instanceId":"i-1"},{"instanceId":"i-2"}]}}`)
+		case "/aws/ec2/group/sig-1/instanceHealthiness":
+			if atomic.LoadInt32(&polls) < 2 {
+				fmt.Fprint(w, `{"response":{"items":[{"instanceId":"i-1","healthStatus":"HEALTHY"},{"instanceId":"i-2","healthStatus":"INSUFFICIENT_DATA"}]}}`)
+				return
+			}
+			fmt.Fprint(w, `{"response":{"items":[{"instanceId":"i-1","healthStatus":"HEALTHY"},{"instanceId":"i-2","healthStatus":"HEALTHY"}]}}`)
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	sess := session.New(spotinst.DefaultConfig().
+		WithBaseURL(ts.URL).
+		WithCredentials(credentials.NewStaticCredentials("token", "")))
+	svc := New(sess)
+
+	// Not stable within the timeout.
+	st, err := WaitUntilStable(context.Background(), svc, "sig-1", &waiter.Options{
+		PollInterval: time.Hour,
+		Timeout:      20 * time.Millisecond,
+	})
+
+	var stErr *waiter.StabilityError
+	if assert.True(t, errors.As(err, &stErr)) {
+		assert.True(t, errors.Is(err, waiter.ErrNotStable))
+		assert.True(t, errors.Is(err, waiter.ErrTimeout))
+		assert.Equal(t, []string{"i-2"}, stErr.Stability.Unhealthy)
+		assert.Equal(t, 1, st.Missing())
+	}
+
+	// Stable.
+	st, err = WaitUntilStable(context.Background(), svc, "sig-1", &waiter.Options{
+		PollInterval: time.Millisecond,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, st.Stable())
+	assert.Equal(t, []string{"i-1", "i-2"}, st.Healthy)
+}
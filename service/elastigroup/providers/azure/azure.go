@@ -46,6 +46,10 @@ type Group struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Scheduling struct {
@@ -53,6 +57,7 @@ type Scheduling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Integration struct {
@@ -62,6 +67,7 @@ type Integration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type KubernetesIntegration struct {
@@ -69,6 +75,7 @@ type KubernetesIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type MultaiIntegration struct {
@@ -76,6 +83,7 @@ type MultaiIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RancherIntegration struct {
@@ -85,6 +93,7 @@ type RancherIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ScheduledTask struct {
@@ -102,6 +111,7 @@ type ScheduledTask struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Scaling struct {
@@ -110,6 +120,7 @@ type Scaling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ScalingPolicy struct {
@@ -131,6 +142,7 @@ type ScalingPolicy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Action struct {
@@ -144,6 +156,7 @@ type Action struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Dimension struct {
@@ -152,6 +165,7 @@ type Dimension struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Strategy struct {
@@ -162,6 +176,7 @@ type Strategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Signal struct {
@@ -170,6 +185,7 @@ type Signal struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Capacity struct {
@@ -179,6 +195,7 @@ type Capacity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Compute struct {
@@ -191,6 +208,7 @@ type Compute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type VMSizes struct {
@@ -199,6 +217,7 @@ type VMSizes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LaunchSpecification struct {
@@ -214,6 +233,7 @@ type LaunchSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LoadBalancersConfig struct {
@@ -221,6 +241,7 @@ type LoadBalancersConfig struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LoadBalancer struct {
@@ -231,6 +252,7 @@ type LoadBalancer struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ManagedServiceIdentity struct {
@@ -239,6 +261,7 @@ type ManagedServiceIdentity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Image struct {
@@ -247,6 +270,7 @@ type Image struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type MarketPlaceImage struct {
@@ -256,6 +280,7 @@ type MarketPlaceImage struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type CustomImage struct {
@@ -264,6 +289,7 @@ type CustomImage struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ResourceFile struct {
@@ -272,6 +298,7 @@ type ResourceFile struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Storage struct {
@@ -279,6 +306,7 @@ type Storage struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Network struct {
@@ -290,6 +318,7 @@ type Network struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AdditionalIPConfigs struct {
@@ -298,6 +327,7 @@ type AdditionalIPConfigs struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Login struct {
@@ -307,6 +337,7 @@ type Login struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Health struct {
@@ -316,6 +347,7 @@ type Health struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Node struct {
@@ -415,6 +447,7 @@ type Roll struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RollItem struct {
@@ -436,6 +469,7 @@ type RollStatus struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RollProgress struct {
@@ -444,6 +478,7 @@ type RollProgress struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type StopRollInput struct {
@@ -478,6 +513,7 @@ type NodeSignal struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type NodeSignalInput struct {
@@ -498,6 +534,7 @@ type Task struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type TaskPolicy struct {
@@ -506,6 +543,7 @@ type TaskPolicy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type TaskInstance struct {
@@ -514,6 +552,7 @@ type TaskInstance struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListTasksInput struct{}
@@ -766,6 +805,10 @@ func (s *ServiceOp) List(ctx context.Context, input *ListGroupsInput) (*ListGrou
 }
 
 func (s *ServiceOp) Create(ctx context.Context, input *CreateGroupInput) (*CreateGroupOutput, error) {
+	if err := s.Client.ValidateCreate(input.Group); err != nil {
+		return nil, err
+	}
+
 	r := client.NewRequest(http.MethodPost, "/compute/azure/group")
 	r.Obj = input
 
@@ -817,6 +860,10 @@ func (s *ServiceOp) Read(ctx context.Context, input *ReadGroupInput) (*ReadGroup
 }
 
 func (s *ServiceOp) Update(ctx context.Context, input *UpdateGroupInput) (*UpdateGroupOutput, error) {
+	if err := s.Client.Validate(input.Group); err != nil {
+		return nil, err
+	}
+
 	path, err := uritemplates.Expand("/compute/azure/group/{groupId}", uritemplates.Values{
 		"groupId": spotinst.StringValue(input.Group.ID),
 	})
@@ -825,10 +872,13 @@ func (s *ServiceOp) Update(ctx context.Context, input *UpdateGroupInput) (*Updat
 	}
 
 	// We do NOT need the ID anymore, so let's drop it.
-	input.Group.ID = nil
+	in := *input
+	group := *input.Group
+	group.ID = nil
+	in.Group = &group
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -899,10 +949,12 @@ func (s *ServiceOp) Detach(ctx context.Context, input *DetachGroupInput) (*Detac
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.NotIdempotent = true
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -989,10 +1041,11 @@ func (s *ServiceOp) UpdateTask(ctx context.Context, input *UpdateTaskInput) (*Up
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Task.ID = nil
+	task := *input.Task
+	task.ID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input.Task
+	r.Obj = &task
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1040,10 +1093,12 @@ func (s *ServiceOp) Roll(ctx context.Context, input *RollGroupInput) (*RollGroup
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.NotIdempotent = true
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1069,10 +1124,11 @@ func (s *ServiceOp) GetRollStatus(ctx context.Context, input *RollStatusInput) (
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodGet, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1102,10 +1158,11 @@ func (s *ServiceOp) ListRollStatus(ctx context.Context, input *ListRollStatusInp
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodGet, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1131,11 +1188,12 @@ func (s *ServiceOp) StopRoll(ctx context.Context, input *StopRollInput) (*StopRo
 	}
 
 	// We do not need the IDs anymore so let's drop them.
-	input.GroupID = nil
-	input.RollID = nil
+	in := *input
+	in.GroupID = nil
+	in.RollID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1178,14 +1236,16 @@ func (s *ServiceOp) Scale(ctx context.Context, input *ScaleGroupInput) (*ScaleGr
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.GroupID = nil
+	in := *input
+	in.GroupID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.NotIdempotent = true
 
 	if input.Adjustment != nil {
 		r.Params.Set("adjustment", strconv.Itoa(*input.Adjustment))
 	}
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1203,7 +1263,12 @@ func (s *ServiceOp) Scale(ctx context.Context, input *ScaleGroupInput) (*ScaleGr
 func (o Group) MarshalJSON() ([]byte, error) {
 	type noMethod Group
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Group) UnmarshalJSON(b []byte) error {
+	type noMethod Group
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Group) SetId(v *string) *Group {
@@ -1290,7 +1355,12 @@ func (o *Group) SetRegion(v *string) *Group {
 func (o Scheduling) MarshalJSON() ([]byte, error) {
 	type noMethod Scheduling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scheduling) UnmarshalJSON(b []byte) error {
+	type noMethod Scheduling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scheduling) SetTasks(v []*ScheduledTask) *Scheduling {
@@ -1307,7 +1377,12 @@ func (o *Scheduling) SetTasks(v []*ScheduledTask) *Scheduling {
 func (o Integration) MarshalJSON() ([]byte, error) {
 	type noMethod Integration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Integration) UnmarshalJSON(b []byte) error {
+	type noMethod Integration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Integration) SetKubernetes(v *KubernetesIntegration) *Integration {
@@ -1338,7 +1413,12 @@ func (o *Integration) SetRancher(v *RancherIntegration) *Integration {
 func (o KubernetesIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod KubernetesIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *KubernetesIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod KubernetesIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *KubernetesIntegration) SetClusterIdentifier(v *string) *KubernetesIntegration {
@@ -1355,7 +1435,12 @@ func (o *KubernetesIntegration) SetClusterIdentifier(v *string) *KubernetesInteg
 func (o MultaiIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod MultaiIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *MultaiIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod MultaiIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *MultaiIntegration) SetDeploymentId(v *string) *MultaiIntegration {
@@ -1372,7 +1457,12 @@ func (o *MultaiIntegration) SetDeploymentId(v *string) *MultaiIntegration {
 func (o RancherIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod RancherIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RancherIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod RancherIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RancherIntegration) SetMasterHost(v *string) *RancherIntegration {
@@ -1403,7 +1493,12 @@ func (o *RancherIntegration) SetSecretKey(v *string) *RancherIntegration {
 func (o ScheduledTask) MarshalJSON() ([]byte, error) {
 	type noMethod ScheduledTask
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ScheduledTask) UnmarshalJSON(b []byte) error {
+	type noMethod ScheduledTask
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ScheduledTask) SetIsEnabled(v *bool) *ScheduledTask {
@@ -1490,7 +1585,12 @@ func (o *ScheduledTask) SetAdjustmentPercentage(v *int) *ScheduledTask {
 func (o Scaling) MarshalJSON() ([]byte, error) {
 	type noMethod Scaling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scaling) UnmarshalJSON(b []byte) error {
+	type noMethod Scaling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scaling) SetUp(v []*ScalingPolicy) *Scaling {
@@ -1514,7 +1614,12 @@ func (o *Scaling) SetDown(v []*ScalingPolicy) *Scaling {
 func (o ScalingPolicy) MarshalJSON() ([]byte, error) {
 	type noMethod ScalingPolicy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ScalingPolicy) UnmarshalJSON(b []byte) error {
+	type noMethod ScalingPolicy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ScalingPolicy) SetPolicyName(v *string) *ScalingPolicy {
@@ -1629,7 +1734,12 @@ func (o *ScalingPolicy) SetAction(v *Action) *ScalingPolicy {
 func (o Action) MarshalJSON() ([]byte, error) {
 	type noMethod Action
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Action) UnmarshalJSON(b []byte) error {
+	type noMethod Action
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Action) SetType(v *string) *Action {
@@ -1688,7 +1798,12 @@ func (o *Action) SetTarget(v *string) *Action {
 func (o Dimension) MarshalJSON() ([]byte, error) {
 	type noMethod Dimension
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Dimension) UnmarshalJSON(b []byte) error {
+	type noMethod Dimension
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Dimension) SetName(v *string) *Dimension {
@@ -1712,7 +1827,12 @@ func (o *Dimension) SetValue(v *string) *Dimension {
 func (o Strategy) MarshalJSON() ([]byte, error) {
 	type noMethod Strategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Strategy) UnmarshalJSON(b []byte) error {
+	type noMethod Strategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Strategy) SetLowPriorityPercentage(v *int) *Strategy {
@@ -1750,7 +1870,12 @@ func (o *Strategy) SetSignals(v []*Signal) *Strategy {
 func (o Signal) MarshalJSON() ([]byte, error) {
 	type noMethod Signal
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Signal) UnmarshalJSON(b []byte) error {
+	type noMethod Signal
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Signal) SetName(v *string) *Signal {
@@ -1774,7 +1899,12 @@ func (o *Signal) SetTimeout(v *int) *Signal {
 func (o Capacity) MarshalJSON() ([]byte, error) {
 	type noMethod Capacity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Capacity) UnmarshalJSON(b []byte) error {
+	type noMethod Capacity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Capacity) SetMinimum(v *int) *Capacity {
@@ -1805,7 +1935,12 @@ func (o *Capacity) SetTarget(v *int) *Capacity {
 func (o Compute) MarshalJSON() ([]byte, error) {
 	type noMethod Compute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Compute) UnmarshalJSON(b []byte) error {
+	type noMethod Compute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Compute) SetRegion(v *string) *Compute {
@@ -1857,7 +1992,12 @@ func (o *Compute) SetHealth(v *Health) *Compute {
 func (o VMSizes) MarshalJSON() ([]byte, error) {
 	type noMethod VMSizes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *VMSizes) UnmarshalJSON(b []byte) error {
+	type noMethod VMSizes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *VMSizes) SetOnDemand(v []string) *VMSizes {
@@ -1881,7 +2021,12 @@ func (o *VMSizes) SetLowPriority(v []string) *VMSizes {
 func (o LaunchSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod LaunchSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LaunchSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod LaunchSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LaunchSpecification) SetLoadBalancersConfig(v *LoadBalancersConfig) *LaunchSpecification {
@@ -1955,7 +2100,12 @@ func (o *LaunchSpecification) SetLogin(v *Login) *LaunchSpecification {
 func (o LoadBalancersConfig) MarshalJSON() ([]byte, error) {
 	type noMethod LoadBalancersConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LoadBalancersConfig) UnmarshalJSON(b []byte) error {
+	type noMethod LoadBalancersConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LoadBalancersConfig) SetLoadBalancers(v []*LoadBalancer) *LoadBalancersConfig {
@@ -1972,7 +2122,12 @@ func (o *LoadBalancersConfig) SetLoadBalancers(v []*LoadBalancer) *LoadBalancers
 func (o LoadBalancer) MarshalJSON() ([]byte, error) {
 	type noMethod LoadBalancer
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LoadBalancer) UnmarshalJSON(b []byte) error {
+	type noMethod LoadBalancer
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LoadBalancer) SetType(v *string) *LoadBalancer {
@@ -2010,7 +2165,12 @@ func (o *LoadBalancer) SetAutoWeight(v *bool) *LoadBalancer {
 func (o ManagedServiceIdentity) MarshalJSON() ([]byte, error) {
 	type noMethod ManagedServiceIdentity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ManagedServiceIdentity) UnmarshalJSON(b []byte) error {
+	type noMethod ManagedServiceIdentity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ManagedServiceIdentity) SetResourceGroupName(v *string) *ManagedServiceIdentity {
@@ -2034,7 +2194,12 @@ func (o *ManagedServiceIdentity) SetName(v *string) *ManagedServiceIdentity {
 func (o Image) MarshalJSON() ([]byte, error) {
 	type noMethod Image
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Image) UnmarshalJSON(b []byte) error {
+	type noMethod Image
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Image) SetMarketPlaceImage(v *MarketPlaceImage) *Image {
@@ -2058,7 +2223,12 @@ func (o *Image) SetCustom(v *CustomImage) *Image {
 func (o MarketPlaceImage) MarshalJSON() ([]byte, error) {
 	type noMethod MarketPlaceImage
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *MarketPlaceImage) UnmarshalJSON(b []byte) error {
+	type noMethod MarketPlaceImage
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *MarketPlaceImage) SetPublisher(v *string) *MarketPlaceImage {
@@ -2089,7 +2259,12 @@ func (o *MarketPlaceImage) SetSKU(v *string) *MarketPlaceImage {
 func (o CustomImage) MarshalJSON() ([]byte, error) {
 	type noMethod CustomImage
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *CustomImage) UnmarshalJSON(b []byte) error {
+	type noMethod CustomImage
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *CustomImage) SetResourceGroupName(v *string) *CustomImage {
@@ -2113,7 +2288,12 @@ func (o *CustomImage) SetImageName(v *string) *CustomImage {
 func (o ResourceFile) MarshalJSON() ([]byte, error) {
 	type noMethod ResourceFile
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ResourceFile) UnmarshalJSON(b []byte) error {
+	type noMethod ResourceFile
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ResourceFile) SetURL(v *string) *ResourceFile {
@@ -2137,7 +2317,12 @@ func (o *ResourceFile) SetTargetPath(v *string) *ResourceFile {
 func (o Storage) MarshalJSON() ([]byte, error) {
 	type noMethod Storage
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Storage) UnmarshalJSON(b []byte) error {
+	type noMethod Storage
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Storage) SetAccountName(v *string) *Storage {
@@ -2154,7 +2339,12 @@ func (o *Storage) SetAccountName(v *string) *Storage {
 func (o Network) MarshalJSON() ([]byte, error) {
 	type noMethod Network
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Network) UnmarshalJSON(b []byte) error {
+	type noMethod Network
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Network) SetVirtualNetworkName(v *string) *Network {
@@ -2200,7 +2390,12 @@ func (o *Network) SetAdditionalIPConfigs(v []*AdditionalIPConfigs) *Network {
 func (o Login) MarshalJSON() ([]byte, error) {
 	type noMethod Login
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Login) UnmarshalJSON(b []byte) error {
+	type noMethod Login
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Login) SetUserName(v *string) *Login {
@@ -2231,7 +2426,12 @@ func (o *Login) SetPassword(v *string) *Login {
 func (o AdditionalIPConfigs) MarshalJSON() ([]byte, error) {
 	type noMethod AdditionalIPConfigs
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AdditionalIPConfigs) UnmarshalJSON(b []byte) error {
+	type noMethod AdditionalIPConfigs
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetName sets the name
@@ -2257,7 +2457,12 @@ func (o *AdditionalIPConfigs) SetPrivateIPAddressVersion(v *string) *AdditionalI
 func (o Health) MarshalJSON() ([]byte, error) {
 	type noMethod Health
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Health) UnmarshalJSON(b []byte) error {
+	type noMethod Health
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Health) SetHealthCheckType(v *string) *Health {
@@ -2288,7 +2493,12 @@ func (o *Health) SetGracePeriod(v *int) *Health {
 func (o NodeSignal) MarshalJSON() ([]byte, error) {
 	type noMethod NodeSignal
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NodeSignal) UnmarshalJSON(b []byte) error {
+	type noMethod NodeSignal
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *NodeSignal) SetNodeID(v *string) *NodeSignal {
@@ -2319,7 +2529,12 @@ func (o *NodeSignal) SetSignal(v *string) *NodeSignal {
 func (o RollStatus) MarshalJSON() ([]byte, error) {
 	type noMethod RollStatus
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RollStatus) UnmarshalJSON(b []byte) error {
+	type noMethod RollStatus
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RollStatus) SetGroupID(v *string) *RollStatus {
@@ -2357,7 +2572,12 @@ func (o *RollStatus) SetProgress(v *RollProgress) *RollStatus {
 func (o RollProgress) MarshalJSON() ([]byte, error) {
 	type noMethod RollProgress
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RollProgress) UnmarshalJSON(b []byte) error {
+	type noMethod RollProgress
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RollProgress) SetUnit(v *string) *RollProgress {
@@ -2381,7 +2601,12 @@ func (o *RollProgress) SetValue(v *int) *RollProgress {
 func (o Roll) MarshalJSON() ([]byte, error) {
 	type noMethod Roll
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Roll) UnmarshalJSON(b []byte) error {
+	type noMethod Roll
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Roll) SetStatus(v *string) *Roll {
@@ -2398,7 +2623,12 @@ func (o *Roll) SetStatus(v *string) *Roll {
 func (o Task) MarshalJSON() ([]byte, error) {
 	type noMethod Task
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Task) UnmarshalJSON(b []byte) error {
+	type noMethod Task
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Task) SetId(v *string) *Task {
@@ -2450,7 +2680,12 @@ func (o *Task) SetInstances(v []*TaskInstance) *Task {
 func (o TaskPolicy) MarshalJSON() ([]byte, error) {
 	type noMethod TaskPolicy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *TaskPolicy) UnmarshalJSON(b []byte) error {
+	type noMethod TaskPolicy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *TaskPolicy) SetCron(v *string) *TaskPolicy {
@@ -2474,7 +2709,12 @@ func (o *TaskPolicy) SetAction(v *string) *TaskPolicy {
 func (o TaskInstance) MarshalJSON() ([]byte, error) {
 	type noMethod TaskInstance
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *TaskInstance) UnmarshalJSON(b []byte) error {
+	type noMethod TaskInstance
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *TaskInstance) SetVMName(v *string) *TaskInstance {
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -9,6 +10,8 @@ import (
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -135,3 +138,69 @@ func TestCreateTask(t *testing.T) {
 	assert.Equal(t, *output.Task.Instances[0].VMName, *input.Task.Instances[0].VMName)
 	assert.Equal(t, *output.Task.Instances[0].ResourceGroupName, *input.Task.Instances[0].ResourceGroupName)
 }
+
+func TestGroupValidate(t *testing.T) {
+	group := &Group{
+		Capacity: &Capacity{Minimum: spotinst.Int(3), Maximum: spotinst.Int(2)},
+		Strategy: &Strategy{LowPriorityPercentage: spotinst.Int(120), OnDemandCount: spotinst.Int(1)},
+		Compute:  &Compute{VMSizes: &VMSizes{OnDemand: []string{}}},
+		Scaling: &Scaling{
+			Down: []*ScalingPolicy{{PolicyName: spotinst.String("down"), MetricName: spotinst.String("cpu")}},
+		},
+	}
+
+	err := group.Validate()
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"capacity.minimum: must not be greater than maximum (3 > 2); "+
+		"strategy.lowPriorityPercentage: must be between 0 and 100, got 120; "+
+		"strategy.onDemandCount: must not be set along with lowPriorityPercentage; "+
+		"compute.vmSizes.odSizes: must not be empty; "+
+		"scaling.down[0].namespace: must be set")
+
+	// Partial groups, e.g. updates, are valid.
+	assert.NoError(t, (&Group{Strategy: &Strategy{OnDemandCount: spotinst.Int(1)}}).Validate())
+	assert.NoError(t, (*Group)(nil).Validate())
+
+	// Creates require the mandatory fields.
+	group = &Group{
+		Name:              spotinst.String("foo"),
+		ResourceGroupName: spotinst.String("bar"),
+		Compute:           &Compute{VMSizes: &VMSizes{OnDemand: []string{"basic_a1"}}},
+	}
+	assert.NoError(t, group.Validate())
+	assert.EqualError(t, group.ValidateCreate(), "spotinst: invalid resource: compute.vmSizes.lowPrioritySizes: must be set")
+
+	group.Compute.VMSizes.SetLowPriority([]string{"basic_a2"})
+	assert.NoError(t, group.ValidateCreate())
+
+	group.Compute = nil
+	assert.EqualError(t, group.ValidateCreate(), "spotinst: invalid resource: compute: must be set")
+}
+
+func TestGroupValidation(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	id, err := srv.Put("/compute/azure/group", &Group{Name: spotinst.String("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := New(srv.Session(), new(spotinst.Config).WithValidation(true))
+	ctx := context.Background()
+
+	_, err = svc.Create(ctx, &CreateGroupInput{Group: &Group{Capacity: &Capacity{Minimum: spotinst.Int(3), Maximum: spotinst.Int(2)}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"name: must be set; resourceGroupName: must be set; compute: must be set; "+
+		"capacity.minimum: must not be greater than maximum (3 > 2)")
+
+	vmSizes := new(VMSizes)
+	vmSizes.SetLowPriority(nil)
+	_, err = svc.Update(ctx, &UpdateGroupInput{Group: &Group{ID: spotinst.String(id), Compute: &Compute{VMSizes: vmSizes}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: compute.vmSizes.lowPrioritySizes: must not be empty")
+
+	// Partial updates are valid.
+	_, err = svc.Update(ctx, &UpdateGroupInput{Group: &Group{ID: spotinst.String(id), Capacity: &Capacity{Target: spotinst.Int(2)}}})
+	assert.NoError(t, err)
+}
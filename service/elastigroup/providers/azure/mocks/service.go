@@ -0,0 +1,528 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of azure.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of azure.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(context.Context, *azure.ListGroupsInput) (*azure.ListGroupsOutput, error)
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(context.Context, *azure.CreateGroupInput) (*azure.CreateGroupOutput, error)
+
+	// ReadFunc, if set, is called by Read.
+	ReadFunc func(context.Context, *azure.ReadGroupInput) (*azure.ReadGroupOutput, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(context.Context, *azure.UpdateGroupInput) (*azure.UpdateGroupOutput, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(context.Context, *azure.DeleteGroupInput) (*azure.DeleteGroupOutput, error)
+
+	// StatusFunc, if set, is called by Status.
+	StatusFunc func(context.Context, *azure.StatusGroupInput) (*azure.StatusGroupOutput, error)
+
+	// DetachFunc, if set, is called by Detach.
+	DetachFunc func(context.Context, *azure.DetachGroupInput) (*azure.DetachGroupOutput, error)
+
+	// ScaleFunc, if set, is called by Scale.
+	ScaleFunc func(context.Context, *azure.ScaleGroupInput) (*azure.ScaleGroupOutput, error)
+
+	// CreateNodeSignalFunc, if set, is called by CreateNodeSignal.
+	CreateNodeSignalFunc func(context.Context, *azure.NodeSignalInput) (*azure.NodeSignalOutput, error)
+
+	// RollFunc, if set, is called by Roll.
+	RollFunc func(context.Context, *azure.RollGroupInput) (*azure.RollGroupOutput, error)
+
+	// GetRollStatusFunc, if set, is called by GetRollStatus.
+	GetRollStatusFunc func(context.Context, *azure.RollStatusInput) (*azure.RollStatusOutput, error)
+
+	// ListRollStatusFunc, if set, is called by ListRollStatus.
+	ListRollStatusFunc func(context.Context, *azure.ListRollStatusInput) (*azure.ListRollStatusOutput, error)
+
+	// StopRollFunc, if set, is called by StopRoll.
+	StopRollFunc func(context.Context, *azure.StopRollInput) (*azure.StopRollOutput, error)
+
+	// ListTasksFunc, if set, is called by ListTasks.
+	ListTasksFunc func(context.Context, *azure.ListTasksInput) (*azure.ListTasksOutput, error)
+
+	// CreateTaskFunc, if set, is called by CreateTask.
+	CreateTaskFunc func(context.Context, *azure.CreateTaskInput) (*azure.CreateTaskOutput, error)
+
+	// ReadTaskFunc, if set, is called by ReadTask.
+	ReadTaskFunc func(context.Context, *azure.ReadTaskInput) (*azure.ReadTaskOutput, error)
+
+	// UpdateTaskFunc, if set, is called by UpdateTask.
+	UpdateTaskFunc func(context.Context, *azure.UpdateTaskInput) (*azure.UpdateTaskOutput, error)
+
+	// DeleteTaskFunc, if set, is called by DeleteTask.
+	DeleteTaskFunc func(context.Context, *azure.DeleteTaskInput) (*azure.DeleteTaskOutput, error)
+}
+
+var _ azure.Service = (*Service)(nil)
+
+// List records the call and returns the configured results.
+func (m *Service) List(ctx context.Context, input *azure.ListGroupsInput) (*azure.ListGroupsOutput, error) {
+	m.Record("List", ctx, input)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.ListGroupsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("List", 2); ok {
+		if r0, ok = rs[0].(*azure.ListGroupsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: List returns *azure.ListGroupsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: List returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.ListGroupsOutput)
+	}
+
+	return r0, r1
+}
+
+// Create records the call and returns the configured results.
+func (m *Service) Create(ctx context.Context, input *azure.CreateGroupInput) (*azure.CreateGroupOutput, error) {
+	m.Record("Create", ctx, input)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.CreateGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Create", 2); ok {
+		if r0, ok = rs[0].(*azure.CreateGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Create returns *azure.CreateGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Create returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.CreateGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Read records the call and returns the configured results.
+func (m *Service) Read(ctx context.Context, input *azure.ReadGroupInput) (*azure.ReadGroupOutput, error) {
+	m.Record("Read", ctx, input)
+	if m.ReadFunc != nil {
+		return m.ReadFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.ReadGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Read", 2); ok {
+		if r0, ok = rs[0].(*azure.ReadGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Read returns *azure.ReadGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Read returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.ReadGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Update records the call and returns the configured results.
+func (m *Service) Update(ctx context.Context, input *azure.UpdateGroupInput) (*azure.UpdateGroupOutput, error) {
+	m.Record("Update", ctx, input)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.UpdateGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Update", 2); ok {
+		if r0, ok = rs[0].(*azure.UpdateGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Update returns *azure.UpdateGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Update returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.UpdateGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Delete records the call and returns the configured results.
+func (m *Service) Delete(ctx context.Context, input *azure.DeleteGroupInput) (*azure.DeleteGroupOutput, error) {
+	m.Record("Delete", ctx, input)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.DeleteGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Delete", 2); ok {
+		if r0, ok = rs[0].(*azure.DeleteGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Delete returns *azure.DeleteGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Delete returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.DeleteGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Status records the call and returns the configured results.
+func (m *Service) Status(ctx context.Context, input *azure.StatusGroupInput) (*azure.StatusGroupOutput, error) {
+	m.Record("Status", ctx, input)
+	if m.StatusFunc != nil {
+		return m.StatusFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.StatusGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Status", 2); ok {
+		if r0, ok = rs[0].(*azure.StatusGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Status returns *azure.StatusGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Status returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.StatusGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Detach records the call and returns the configured results.
+func (m *Service) Detach(ctx context.Context, input *azure.DetachGroupInput) (*azure.DetachGroupOutput, error) {
+	m.Record("Detach", ctx, input)
+	if m.DetachFunc != nil {
+		return m.DetachFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.DetachGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Detach", 2); ok {
+		if r0, ok = rs[0].(*azure.DetachGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Detach returns *azure.DetachGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Detach returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.DetachGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Scale records the call and returns the configured results.
+func (m *Service) Scale(ctx context.Context, input *azure.ScaleGroupInput) (*azure.ScaleGroupOutput, error) {
+	m.Record("Scale", ctx, input)
+	if m.ScaleFunc != nil {
+		return m.ScaleFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.ScaleGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Scale", 2); ok {
+		if r0, ok = rs[0].(*azure.ScaleGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Scale returns *azure.ScaleGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Scale returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.ScaleGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateNodeSignal records the call and returns the configured results.
+func (m *Service) CreateNodeSignal(ctx context.Context, input *azure.NodeSignalInput) (*azure.NodeSignalOutput, error) {
+	m.Record("CreateNodeSignal", ctx, input)
+	if m.CreateNodeSignalFunc != nil {
+		return m.CreateNodeSignalFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.NodeSignalOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateNodeSignal", 2); ok {
+		if r0, ok = rs[0].(*azure.NodeSignalOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateNodeSignal returns *azure.NodeSignalOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateNodeSignal returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.NodeSignalOutput)
+	}
+
+	return r0, r1
+}
+
+// Roll records the call and returns the configured results.
+func (m *Service) Roll(ctx context.Context, input *azure.RollGroupInput) (*azure.RollGroupOutput, error) {
+	m.Record("Roll", ctx, input)
+	if m.RollFunc != nil {
+		return m.RollFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.RollGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Roll", 2); ok {
+		if r0, ok = rs[0].(*azure.RollGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Roll returns *azure.RollGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Roll returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.RollGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// GetRollStatus records the call and returns the configured results.
+func (m *Service) GetRollStatus(ctx context.Context, input *azure.RollStatusInput) (*azure.RollStatusOutput, error) {
+	m.Record("GetRollStatus", ctx, input)
+	if m.GetRollStatusFunc != nil {
+		return m.GetRollStatusFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.RollStatusOutput
+		r1 error
+	)
+	if rs, ok := m.Result("GetRollStatus", 2); ok {
+		if r0, ok = rs[0].(*azure.RollStatusOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: GetRollStatus returns *azure.RollStatusOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: GetRollStatus returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.RollStatusOutput)
+	}
+
+	return r0, r1
+}
+
+// ListRollStatus records the call and returns the configured results.
+func (m *Service) ListRollStatus(ctx context.Context, input *azure.ListRollStatusInput) (*azure.ListRollStatusOutput, error) {
+	m.Record("ListRollStatus", ctx, input)
+	if m.ListRollStatusFunc != nil {
+		return m.ListRollStatusFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.ListRollStatusOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListRollStatus", 2); ok {
+		if r0, ok = rs[0].(*azure.ListRollStatusOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListRollStatus returns *azure.ListRollStatusOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListRollStatus returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.ListRollStatusOutput)
+	}
+
+	return r0, r1
+}
+
+// StopRoll records the call and returns the configured results.
+func (m *Service) StopRoll(ctx context.Context, input *azure.StopRollInput) (*azure.StopRollOutput, error) {
+	m.Record("StopRoll", ctx, input)
+	if m.StopRollFunc != nil {
+		return m.StopRollFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.StopRollOutput
+		r1 error
+	)
+	if rs, ok := m.Result("StopRoll", 2); ok {
+		if r0, ok = rs[0].(*azure.StopRollOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: StopRoll returns *azure.StopRollOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: StopRoll returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.StopRollOutput)
+	}
+
+	return r0, r1
+}
+
+// ListTasks records the call and returns the configured results.
+func (m *Service) ListTasks(ctx context.Context, input *azure.ListTasksInput) (*azure.ListTasksOutput, error) {
+	m.Record("ListTasks", ctx, input)
+	if m.ListTasksFunc != nil {
+		return m.ListTasksFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.ListTasksOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListTasks", 2); ok {
+		if r0, ok = rs[0].(*azure.ListTasksOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListTasks returns *azure.ListTasksOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListTasks returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.ListTasksOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateTask records the call and returns the configured results.
+func (m *Service) CreateTask(ctx context.Context, input *azure.CreateTaskInput) (*azure.CreateTaskOutput, error) {
+	m.Record("CreateTask", ctx, input)
+	if m.CreateTaskFunc != nil {
+		return m.CreateTaskFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.CreateTaskOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateTask", 2); ok {
+		if r0, ok = rs[0].(*azure.CreateTaskOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateTask returns *azure.CreateTaskOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateTask returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.CreateTaskOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadTask records the call and returns the configured results.
+func (m *Service) ReadTask(ctx context.Context, input *azure.ReadTaskInput) (*azure.ReadTaskOutput, error) {
+	m.Record("ReadTask", ctx, input)
+	if m.ReadTaskFunc != nil {
+		return m.ReadTaskFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.ReadTaskOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadTask", 2); ok {
+		if r0, ok = rs[0].(*azure.ReadTaskOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadTask returns *azure.ReadTaskOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadTask returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.ReadTaskOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateTask records the call and returns the configured results.
+func (m *Service) UpdateTask(ctx context.Context, input *azure.UpdateTaskInput) (*azure.UpdateTaskOutput, error) {
+	m.Record("UpdateTask", ctx, input)
+	if m.UpdateTaskFunc != nil {
+		return m.UpdateTaskFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.UpdateTaskOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateTask", 2); ok {
+		if r0, ok = rs[0].(*azure.UpdateTaskOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateTask returns *azure.UpdateTaskOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateTask returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.UpdateTaskOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteTask records the call and returns the configured results.
+func (m *Service) DeleteTask(ctx context.Context, input *azure.DeleteTaskInput) (*azure.DeleteTaskOutput, error) {
+	m.Record("DeleteTask", ctx, input)
+	if m.DeleteTaskFunc != nil {
+		return m.DeleteTaskFunc(ctx, input)
+	}
+
+	var (
+		r0 *azure.DeleteTaskOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteTask", 2); ok {
+		if r0, ok = rs[0].(*azure.DeleteTaskOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteTask returns *azure.DeleteTaskOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteTask returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(azure.DeleteTaskOutput)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,9 @@
+package azure
+
+import "github.com/spotinst/spotinst-sdk-go/spotinst/spec"
+
+// Register the kinds of the resources of the service, to decode them from spec
+// files.
+func init() {
+	spec.Register("ElastigroupAzure", (*Group)(nil))
+}
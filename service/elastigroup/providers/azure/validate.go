@@ -0,0 +1,102 @@
+package azure
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+)
+
+// Validate returns a validation.Errors listing the invalid fields of the
+// group, if any. Fields that are not set are not validated, so that partial
+// groups, e.g. updates, can be validated as well.
+func (o *Group) Validate() error {
+	return o.validate(false)
+}
+
+// ValidateCreate is like Validate, but also requires the fields that must be
+// set to create a group.
+func (o *Group) ValidateCreate() error {
+	return o.validate(true)
+}
+
+func (o *Group) validate(create bool) error {
+	if o == nil {
+		return nil
+	}
+
+	v := new(validation.Validator)
+	if create {
+		v.Required("name", o.Name)
+		v.Required("resourceGroupName", o.ResourceGroupName)
+		v.Present("compute", o.Compute != nil)
+	}
+	if o.Capacity != nil {
+		v.Capacity("capacity", o.Capacity.Minimum, o.Capacity.Maximum, o.Capacity.Target)
+	}
+	o.Strategy.validate(v, "strategy")
+	if o.Compute != nil {
+		if create {
+			v.Present("compute.vmSizes", o.Compute.VMSizes != nil)
+		}
+		o.Compute.VMSizes.validate(v, "compute.vmSizes", create)
+	}
+	o.Scaling.validate(v, "scaling")
+
+	return v.Err()
+}
+
+func (o *Strategy) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	v.RangeInt(validation.Field(path, "lowPriorityPercentage"), o.LowPriorityPercentage, 0, 100)
+	if o.OnDemandCount != nil {
+		if *o.OnDemandCount < 0 {
+			v.Errorf(validation.Field(path, "onDemandCount"), "must not be negative, got %d", *o.OnDemandCount)
+		}
+		if o.LowPriorityPercentage != nil {
+			v.Errorf(validation.Field(path, "onDemandCount"), "must not be set along with lowPriorityPercentage")
+		}
+	}
+}
+
+func (o *VMSizes) validate(v *validation.Validator, path string, create bool) {
+	if o == nil {
+		return
+	}
+
+	if create {
+		v.Present(validation.Field(path, "odSizes"), o.OnDemand != nil)
+		v.Present(validation.Field(path, "lowPrioritySizes"), o.LowPriority != nil)
+	}
+	if (o.OnDemand != nil && len(o.OnDemand) == 0) || validation.IsNull(o.nullFields, "OnDemand") {
+		v.Errorf(validation.Field(path, "odSizes"), "must not be empty")
+	}
+	if (o.LowPriority != nil && len(o.LowPriority) == 0) || validation.IsNull(o.nullFields, "LowPriority") {
+		v.Errorf(validation.Field(path, "lowPrioritySizes"), "must not be empty")
+	}
+}
+
+func (o *Scaling) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	validateScalingPolicies(v, validation.Field(path, "up"), o.Up)
+	validateScalingPolicies(v, validation.Field(path, "down"), o.Down)
+}
+
+func validateScalingPolicies(v *validation.Validator, path string, policies []*ScalingPolicy) {
+	for i, policy := range policies {
+		policy.validate(v, validation.Index(path, i))
+	}
+}
+
+func (o *ScalingPolicy) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	v.Required(validation.Field(path, "policyName"), o.PolicyName)
+	v.Required(validation.Field(path, "metricName"), o.MetricName)
+	v.Required(validation.Field(path, "namespace"), o.Namespace)
+}
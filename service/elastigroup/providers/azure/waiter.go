@@ -0,0 +1,90 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// WaitUntilRollFinished polls the GetRollStatus of svc until the roll reaches
+// a terminal state. It returns the final roll status, or a *waiter.RollError if
+// the roll failed or was stopped.
+func WaitUntilRollFinished(ctx context.Context, svc Service, groupID, rollID string,
+	opts *waiter.Options) (*RollStatus, error) {
+	var status *RollStatus
+
+	err := waiter.Poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		output, err := svc.GetRollStatus(ctx, &RollStatusInput{
+			GroupID: spotinst.String(groupID),
+			RollID:  spotinst.String(rollID),
+		})
+		if err != nil {
+			return false, err
+		}
+		if output.RollStatus == nil {
+			return false, fmt.Errorf("spotinst: roll %q of group %q not found", rollID, groupID)
+		}
+
+		status = output.RollStatus
+		progress := waiter.Progress{
+			ID:    spotinst.StringValue(status.RollID),
+			State: spotinst.StringValue(status.Status),
+		}
+		if status.Progress != nil {
+			progress.Value = spotinst.IntValue(status.Progress.Value)
+			progress.Unit = spotinst.StringValue(status.Progress.Unit)
+		}
+		opts.Report(progress)
+
+		return waiter.RollDone(groupID, rollID, progress.State)
+	})
+
+	return status, err
+}
+
+// NodeStateRunning is the state of running nodes, as reported by Status.
+const NodeStateRunning = "RUNNING"
+
+// WaitUntilStable polls the group through svc until it runs as many nodes as
+// its target capacity. Azure does not report node health separately, so
+// running nodes are considered healthy. It returns the last observed
+// stability, and a *waiter.StabilityError describing what is still missing if
+// the group did not become stable in time.
+func WaitUntilStable(ctx context.Context, svc Service, groupID string,
+	opts *waiter.Options) (*waiter.Stability, error) {
+	return waiter.PollStable(ctx, groupID, opts, func(ctx context.Context) (*waiter.Stability, error) {
+		return stability(ctx, svc, groupID)
+	})
+}
+
+func stability(ctx context.Context, svc Service, groupID string) (*waiter.Stability, error) {
+	group, err := svc.Read(ctx, &ReadGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := svc.Status(ctx, &StatusGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	st := new(waiter.Stability)
+	if group.Group != nil && group.Group.Capacity != nil {
+		st.Target = spotinst.IntValue(group.Group.Capacity.Target)
+	}
+
+	for _, n := range status.Nodes {
+		id := spotinst.StringValue(n.ID)
+		if strings.EqualFold(spotinst.StringValue(n.State), NodeStateRunning) {
+			st.Running = append(st.Running, id)
+			st.Healthy = append(st.Healthy, id)
+		} else {
+			st.Pending = append(st.Pending, id)
+		}
+	}
+
+	return st, nil
+}
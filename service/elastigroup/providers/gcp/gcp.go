@@ -47,6 +47,10 @@ type Group struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 // region AutoScale structs
@@ -60,6 +64,7 @@ type AutoScale struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleDown struct {
@@ -67,6 +72,7 @@ type AutoScaleDown struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleHeadroom struct {
@@ -76,6 +82,7 @@ type AutoScaleHeadroom struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleLabel struct {
@@ -84,6 +91,7 @@ type AutoScaleLabel struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -98,6 +106,7 @@ type Capacity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -111,6 +120,7 @@ type AccessConfig struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // AliasIPRange defines the alias ip range for a network. AliasIPRange is an element of NetworkInterface.
@@ -120,6 +130,7 @@ type AliasIPRange struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // BackendServiceConfig constains a list of backend service configurations.
@@ -127,6 +138,7 @@ type BackendServiceConfig struct {
 	BackendServices []*BackendService `json:"backendServices,omitempty"`
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // BackendService defines the configuration for a single backend service.
@@ -138,6 +150,7 @@ type BackendService struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // Compute defines the compute attributes of a Group.
@@ -151,6 +164,7 @@ type Compute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // CustomInstance defines the memory and vCPU constraints of an instance
@@ -175,6 +189,7 @@ type Disk struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // GPU defines the kind and number of GPUs to use with the group. GPU is an element of Compute.
@@ -184,6 +199,7 @@ type GPU struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // Health defines the healthcheck attributes for the group. Health is an element of Compute.
@@ -195,6 +211,7 @@ type Health struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // InitializeParams defines the initialization parameters for a Disk object.
@@ -205,6 +222,7 @@ type InitializeParams struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // InstanceTypes defines the type of instances to use with the group. InstanceTypes is an element of Compute.
@@ -215,6 +233,7 @@ type InstanceTypes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // Label defines an object holding a key:value pair. Label is an element of LaunchSpecification.
@@ -224,6 +243,7 @@ type Label struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // LaunchSpecification defines launch attributes for the Group. LaunchSpecification is an element of Compute.
@@ -241,6 +261,7 @@ type LaunchSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // Metadata defines an object holding a key:value pair. Metadata is an element of LaunchSpecification.
@@ -250,6 +271,7 @@ type Metadata struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // NamedPorts describes the name and list of ports to use with the backend service
@@ -259,6 +281,7 @@ type NamedPorts struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // NetworkInterface defines the network configuration for a Group. NetworkInterface is an element of LaunchSpecification.
@@ -269,6 +292,7 @@ type NetworkInterface struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // Subnet defines the attributes of a single Subnet. The Subnets list is an element of Compute.
@@ -278,6 +302,7 @@ type Subnet struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -295,6 +320,7 @@ type ImportGKEGroup struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type CapacityGKE struct {
@@ -310,6 +336,7 @@ type InstanceTypesGKE struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -323,6 +350,7 @@ type Action struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // Dimension defines the attributes for the dimensions of a ScalingPolicy.
@@ -332,6 +360,7 @@ type Dimension struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // Scaling defines the scaling attributes of a Group
@@ -341,6 +370,7 @@ type Scaling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // ScalingPolicy defines the scaling attributes for both up and down policies. ScalingPolicy is an element of Scaling.
@@ -361,6 +391,7 @@ type ScalingPolicy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -376,6 +407,7 @@ type Strategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -387,6 +419,7 @@ type Scheduling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Task struct {
@@ -399,6 +432,7 @@ type Task struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -411,6 +445,7 @@ type Integration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // region GKEIntegration structs
@@ -424,6 +459,7 @@ type GKEIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleGKE struct {
@@ -432,6 +468,7 @@ type AutoScaleGKE struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -444,6 +481,7 @@ type DockerSwarmIntegration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 // endregion
@@ -540,6 +578,10 @@ type UpdateGroupOutput struct {
 
 // Create creates a new Elastigroup using GCE resources.
 func (s *ServiceOp) Create(ctx context.Context, input *CreateGroupInput) (*CreateGroupOutput, error) {
+	if err := s.Client.ValidateCreate(input.Group); err != nil {
+		return nil, err
+	}
+
 	r := client.NewRequest(http.MethodPost, "/gcp/gce/group")
 	r.Obj = input
 
@@ -593,6 +635,10 @@ func (s *ServiceOp) Read(ctx context.Context, input *ReadGroupInput) (*ReadGroup
 
 // Update modifies the configuration of a single existing Elastigroup.
 func (s *ServiceOp) Update(ctx context.Context, input *UpdateGroupInput) (*UpdateGroupOutput, error) {
+	if err := s.Client.Validate(input.Group); err != nil {
+		return nil, err
+	}
+
 	path, err := uritemplates.Expand("/gcp/gce/group/{groupId}", uritemplates.Values{
 		"groupId": spotinst.StringValue(input.Group.ID),
 	})
@@ -601,10 +647,13 @@ func (s *ServiceOp) Update(ctx context.Context, input *UpdateGroupInput) (*Updat
 	}
 
 	// We do NOT need the ID anymore, so let's drop it.
-	input.Group.ID = nil
+	in := *input
+	group := *input.Group
+	group.ID = nil
+	in.Group = &group
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -803,7 +852,12 @@ func instancesFromHttpResponse(resp *http.Response) ([]*Instance, error) {
 func (o Group) MarshalJSON() ([]byte, error) {
 	type noMethod Group
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Group) UnmarshalJSON(b []byte) error {
+	type noMethod Group
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetID sets the group ID attribute
@@ -892,7 +946,12 @@ func (o *Group) SetIntegration(v *Integration) *Group {
 func (o AutoScale) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScale
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScale) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScale
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScale) SetIsEnabled(v *bool) *AutoScale {
@@ -935,7 +994,12 @@ func (o *AutoScale) SetDown(v *AutoScaleDown) *AutoScale {
 func (o AutoScaleDown) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleDown
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleDown) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleDown
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleDown) SetEvaluationPeriods(v *int) *AutoScaleDown {
@@ -952,7 +1016,12 @@ func (o *AutoScaleDown) SetEvaluationPeriods(v *int) *AutoScaleDown {
 func (o AutoScaleHeadroom) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleHeadroom
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleHeadroom) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleHeadroom
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleHeadroom) SetCPUPerUnit(v *int) *AutoScaleHeadroom {
@@ -983,7 +1052,12 @@ func (o *AutoScaleHeadroom) SetNumOfUnits(v *int) *AutoScaleHeadroom {
 func (o AutoScaleLabel) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleLabel
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleLabel) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleLabel
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleLabel) SetKey(v *string) *AutoScaleLabel {
@@ -1009,7 +1083,12 @@ func (o *AutoScaleLabel) SetValue(v *string) *AutoScaleLabel {
 func (o Capacity) MarshalJSON() ([]byte, error) {
 	type noMethod Capacity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Capacity) UnmarshalJSON(b []byte) error {
+	type noMethod Capacity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetMaximum sets the Maximum number of VMs in the group.
@@ -1043,7 +1122,12 @@ func (o *Capacity) SetTarget(v *int) *Capacity {
 func (o Compute) MarshalJSON() ([]byte, error) {
 	type noMethod Compute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Compute) UnmarshalJSON(b []byte) error {
+	type noMethod Compute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetAvailabilityZones sets the list of availability zones for group resources.
@@ -1099,7 +1183,12 @@ func (o *Compute) SetSubnets(v []*Subnet) *Compute {
 func (o GPU) MarshalJSON() ([]byte, error) {
 	type noMethod GPU
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *GPU) UnmarshalJSON(b []byte) error {
+	type noMethod GPU
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetType sets the type of gpu
@@ -1125,7 +1214,12 @@ func (o *GPU) SetCount(v *int) *GPU {
 func (o Health) MarshalJSON() ([]byte, error) {
 	type noMethod Health
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Health) UnmarshalJSON(b []byte) error {
+	type noMethod Health
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetGracePeriod sets the grace period time for the groups health check
@@ -1167,7 +1261,12 @@ func (o *Health) SetUnhealthyDuration(v *int) *Health {
 func (o InstanceTypes) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceTypes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceTypes) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceTypes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetCustom sets the memory and vCPU attributes for Custom Instance types
@@ -1217,7 +1316,12 @@ func (o *InstanceTypes) SetPreemptible(v []string) *InstanceTypes {
 func (o LaunchSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod LaunchSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LaunchSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod LaunchSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetBackendServices sets the backend services to use with the group.
@@ -1305,7 +1409,12 @@ func (o *LaunchSpecification) SetTags(v []string) *LaunchSpecification {
 func (o BackendServiceConfig) MarshalJSON() ([]byte, error) {
 	type noMethod BackendServiceConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BackendServiceConfig) UnmarshalJSON(b []byte) error {
+	type noMethod BackendServiceConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetBackendServices sets the backend service list
@@ -1321,7 +1430,12 @@ func (o *BackendServiceConfig) SetBackendServices(v []*BackendService) *BackendS
 func (o BackendService) MarshalJSON() ([]byte, error) {
 	type noMethod BackendService
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BackendService) UnmarshalJSON(b []byte) error {
+	type noMethod BackendService
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetBackendServiceName sets the name of the backend service.
@@ -1361,7 +1475,12 @@ func (o *BackendService) SetNamedPorts(v *NamedPorts) *BackendService {
 func (o NamedPorts) MarshalJSON() ([]byte, error) {
 	type noMethod NamedPorts
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NamedPorts) UnmarshalJSON(b []byte) error {
+	type noMethod NamedPorts
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetNamedPorts sets the name of the NamedPorts
@@ -1391,7 +1510,12 @@ func (o *NamedPorts) SetPorts(v []int) *NamedPorts {
 func (o Disk) MarshalJSON() ([]byte, error) {
 	type noMethod Disk
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Disk) UnmarshalJSON(b []byte) error {
+	type noMethod Disk
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetAutoDelete sets option to have disks autodelete
@@ -1463,7 +1587,12 @@ func (o *Disk) SetType(v *string) *Disk {
 func (o InitializeParams) MarshalJSON() ([]byte, error) {
 	type noMethod InitializeParams
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InitializeParams) UnmarshalJSON(b []byte) error {
+	type noMethod InitializeParams
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetDiskSizeGB sets the disk size in gigabytes, in multiples of 2
@@ -1499,7 +1628,12 @@ func (o *InitializeParams) SetSourceImage(v *string) *InitializeParams {
 func (o Label) MarshalJSON() ([]byte, error) {
 	type noMethod Label
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Label) UnmarshalJSON(b []byte) error {
+	type noMethod Label
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetKey sets the key for the label
@@ -1525,7 +1659,12 @@ func (o *Label) SetValue(v *string) *Label {
 func (o NetworkInterface) MarshalJSON() ([]byte, error) {
 	type noMethod NetworkInterface
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NetworkInterface) UnmarshalJSON(b []byte) error {
+	type noMethod NetworkInterface
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetAccessConfigs creates a list of one or more access configuration objects
@@ -1557,7 +1696,12 @@ func (o *NetworkInterface) SetNetwork(v *string) *NetworkInterface {
 func (o AccessConfig) MarshalJSON() ([]byte, error) {
 	type noMethod AccessConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AccessConfig) UnmarshalJSON(b []byte) error {
+	type noMethod AccessConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetName sets the name of the access configuration
@@ -1583,7 +1727,12 @@ func (o *AccessConfig) SetType(v *string) *AccessConfig {
 func (o AliasIPRange) MarshalJSON() ([]byte, error) {
 	type noMethod AliasIPRange
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AliasIPRange) UnmarshalJSON(b []byte) error {
+	type noMethod AliasIPRange
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetIPCIDRRange sets the ip/cidr range
@@ -1611,7 +1760,12 @@ func (o *AliasIPRange) SetSubnetworkRangeName(v *string) *AliasIPRange {
 func (o Metadata) MarshalJSON() ([]byte, error) {
 	type noMethod Metadata
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Metadata) UnmarshalJSON(b []byte) error {
+	type noMethod Metadata
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetKey sets the metadata key
@@ -1639,7 +1793,12 @@ func (o *Metadata) SetValue(v *string) *Metadata {
 func (o Subnet) MarshalJSON() ([]byte, error) {
 	type noMethod Subnet
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Subnet) UnmarshalJSON(b []byte) error {
+	type noMethod Subnet
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetRegion sets the region the subnet is in.
@@ -1667,7 +1826,12 @@ func (o *Subnet) SetSubnetNames(v []string) *Subnet {
 func (o ImportGKEGroup) MarshalJSON() ([]byte, error) {
 	type noMethod ImportGKEGroup
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ImportGKEGroup) UnmarshalJSON(b []byte) error {
+	type noMethod ImportGKEGroup
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetAvailabilityZones sets the availability zones for the gke group
@@ -1721,7 +1885,12 @@ func (o *ImportGKEGroup) SetNodeImage(v *string) *ImportGKEGroup {
 func (o InstanceTypesGKE) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceTypesGKE
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceTypesGKE) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceTypesGKE
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetOnDemand sets the instance types when importing a gke group
@@ -1747,7 +1916,12 @@ func (o *InstanceTypesGKE) SetPreemptible(v []string) *InstanceTypesGKE {
 func (o Integration) MarshalJSON() ([]byte, error) {
 	type noMethod Integration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Integration) UnmarshalJSON(b []byte) error {
+	type noMethod Integration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetGKEIntegration sets the GKE integration
@@ -1771,7 +1945,12 @@ func (o *Integration) SetDockerSwarm(v *DockerSwarmIntegration) *Integration {
 func (o GKEIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod GKEIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *GKEIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod GKEIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetAutoUpdate sets the autoupdate flag
@@ -1811,7 +1990,12 @@ func (o *GKEIntegration) SetClusterID(v *string) *GKEIntegration {
 func (o AutoScaleGKE) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleGKE
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleGKE) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleGKE
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetLabels sets the AutoScale labels for the GKE integration
@@ -1831,7 +2015,12 @@ func (o *AutoScaleGKE) SetLabels(v []*AutoScaleLabel) *AutoScaleGKE {
 func (o DockerSwarmIntegration) MarshalJSON() ([]byte, error) {
 	type noMethod DockerSwarmIntegration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *DockerSwarmIntegration) UnmarshalJSON(b []byte) error {
+	type noMethod DockerSwarmIntegration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetMasterPort sets the master port
@@ -1859,7 +2048,12 @@ func (o *DockerSwarmIntegration) SetMasterHost(v *string) *DockerSwarmIntegratio
 func (o Scaling) MarshalJSON() ([]byte, error) {
 	type noMethod Scaling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scaling) UnmarshalJSON(b []byte) error {
+	type noMethod Scaling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetUp sets the scaling policy to usewhen increasing the number of instances in a group.
@@ -1883,7 +2077,12 @@ func (o *Scaling) SetDown(v []*ScalingPolicy) *Scaling {
 func (o ScalingPolicy) MarshalJSON() ([]byte, error) {
 	type noMethod ScalingPolicy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ScalingPolicy) UnmarshalJSON(b []byte) error {
+	type noMethod ScalingPolicy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetAction sets the action to perform when scaling
@@ -1995,7 +2194,12 @@ func (o *ScalingPolicy) SetUnit(v *string) *ScalingPolicy {
 func (o Action) MarshalJSON() ([]byte, error) {
 	type noMethod Action
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Action) UnmarshalJSON(b []byte) error {
+	type noMethod Action
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetAdjustment sets the number associated with the action type
@@ -2021,7 +2225,12 @@ func (o *Action) SetType(v *string) *Action {
 func (o Dimension) MarshalJSON() ([]byte, error) {
 	type noMethod Dimension
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Dimension) UnmarshalJSON(b []byte) error {
+	type noMethod Dimension
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetName sets the name of the dimension
@@ -2051,7 +2260,12 @@ func (o *Dimension) SetValue(v *string) *Dimension {
 func (o Scheduling) MarshalJSON() ([]byte, error) {
 	type noMethod Scheduling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scheduling) UnmarshalJSON(b []byte) error {
+	type noMethod Scheduling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
@@ -2068,7 +2282,12 @@ func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
 func (o Task) MarshalJSON() ([]byte, error) {
 	type noMethod Task
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Task) UnmarshalJSON(b []byte) error {
+	type noMethod Task
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Task) SetIsEnabled(v *bool) *Task {
@@ -2120,7 +2339,12 @@ func (o *Task) SetMaxCapacity(v *int) *Task {
 func (o Strategy) MarshalJSON() ([]byte, error) {
 	type noMethod Strategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Strategy) UnmarshalJSON(b []byte) error {
+	type noMethod Strategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetDrainingTimeout sets the time to keep an instance alive after detaching it from the group
@@ -0,0 +1,79 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupValidate(t *testing.T) {
+	group := &Group{
+		Capacity: &Capacity{Minimum: spotinst.Int(3), Maximum: spotinst.Int(2)},
+		Strategy: &Strategy{PreemptiblePercentage: spotinst.Int(-1), OnDemandCount: spotinst.Int(-1)},
+		Compute:  &Compute{InstanceTypes: &InstanceTypes{Preemptible: []string{}}},
+		Scaling: &Scaling{
+			Up: []*ScalingPolicy{{MetricName: spotinst.String("cpu"), Namespace: spotinst.String("compute")}},
+		},
+	}
+
+	err := group.Validate()
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"capacity.minimum: must not be greater than maximum (3 > 2); "+
+		"strategy.preemptiblePercentage: must be between 0 and 100, got -1; "+
+		"strategy.onDemandCount: must not be negative, got -1; "+
+		"strategy.onDemandCount: must not be set along with preemptiblePercentage; "+
+		"compute.instanceTypes.preemptible: must not be empty; "+
+		"scaling.up[0].policyName: must be set")
+
+	// Partial groups, e.g. updates, are valid.
+	assert.NoError(t, (&Group{Strategy: &Strategy{PreemptiblePercentage: spotinst.Int(50)}}).Validate())
+	assert.NoError(t, (*Group)(nil).Validate())
+
+	// Creates require the mandatory fields.
+	group = &Group{
+		Name:     spotinst.String("foo"),
+		Capacity: &Capacity{Target: spotinst.Int(2)},
+		Compute:  &Compute{InstanceTypes: &InstanceTypes{OnDemand: spotinst.String("n1-standard-1")}},
+	}
+	assert.NoError(t, group.Validate())
+	assert.EqualError(t, group.ValidateCreate(), "spotinst: invalid resource: compute.instanceTypes.preemptible: must be set")
+
+	group.Compute.InstanceTypes.SetPreemptible([]string{"n1-standard-2"})
+	assert.NoError(t, group.ValidateCreate())
+
+	group.Compute = nil
+	assert.EqualError(t, group.ValidateCreate(), "spotinst: invalid resource: compute: must be set")
+}
+
+func TestGroupValidation(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	id, err := srv.Put("/gcp/gce/group", &Group{Name: spotinst.String("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := New(srv.Session(), new(spotinst.Config).WithValidation(true))
+	ctx := context.Background()
+
+	_, err = svc.Create(ctx, &CreateGroupInput{Group: &Group{Strategy: &Strategy{PreemptiblePercentage: spotinst.Int(120)}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"name: must be set; capacity: must be set; compute: must be set; "+
+		"strategy.preemptiblePercentage: must be between 0 and 100, got 120")
+
+	instanceTypes := new(InstanceTypes)
+	instanceTypes.SetPreemptible(nil)
+	_, err = svc.Update(ctx, &UpdateGroupInput{Group: &Group{ID: spotinst.String(id), Compute: &Compute{InstanceTypes: instanceTypes}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: compute.instanceTypes.preemptible: must not be empty")
+
+	// Partial updates are valid.
+	_, err = svc.Update(ctx, &UpdateGroupInput{Group: &Group{ID: spotinst.String(id), Capacity: &Capacity{Target: spotinst.Int(2)}}})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,220 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of gcp.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of gcp.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. CreateFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(context.Context, *gcp.CreateGroupInput) (*gcp.CreateGroupOutput, error)
+
+	// ReadFunc, if set, is called by Read.
+	ReadFunc func(context.Context, *gcp.ReadGroupInput) (*gcp.ReadGroupOutput, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(context.Context, *gcp.UpdateGroupInput) (*gcp.UpdateGroupOutput, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(context.Context, *gcp.DeleteGroupInput) (*gcp.DeleteGroupOutput, error)
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(context.Context, *gcp.ListGroupsInput) (*gcp.ListGroupsOutput, error)
+
+	// ImportGKEClusterFunc, if set, is called by ImportGKECluster.
+	ImportGKEClusterFunc func(context.Context, *gcp.ImportGKEClusterInput) (*gcp.ImportGKEClusterOutput, error)
+
+	// StatusFunc, if set, is called by Status.
+	StatusFunc func(context.Context, *gcp.StatusGroupInput) (*gcp.StatusGroupOutput, error)
+}
+
+var _ gcp.Service = (*Service)(nil)
+
+// Create records the call and returns the configured results.
+func (m *Service) Create(ctx context.Context, input *gcp.CreateGroupInput) (*gcp.CreateGroupOutput, error) {
+	m.Record("Create", ctx, input)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.CreateGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Create", 2); ok {
+		if r0, ok = rs[0].(*gcp.CreateGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Create returns *gcp.CreateGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Create returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.CreateGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Read records the call and returns the configured results.
+func (m *Service) Read(ctx context.Context, input *gcp.ReadGroupInput) (*gcp.ReadGroupOutput, error) {
+	m.Record("Read", ctx, input)
+	if m.ReadFunc != nil {
+		return m.ReadFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ReadGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Read", 2); ok {
+		if r0, ok = rs[0].(*gcp.ReadGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Read returns *gcp.ReadGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Read returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ReadGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Update records the call and returns the configured results.
+func (m *Service) Update(ctx context.Context, input *gcp.UpdateGroupInput) (*gcp.UpdateGroupOutput, error) {
+	m.Record("Update", ctx, input)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.UpdateGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Update", 2); ok {
+		if r0, ok = rs[0].(*gcp.UpdateGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Update returns *gcp.UpdateGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Update returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.UpdateGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// Delete records the call and returns the configured results.
+func (m *Service) Delete(ctx context.Context, input *gcp.DeleteGroupInput) (*gcp.DeleteGroupOutput, error) {
+	m.Record("Delete", ctx, input)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.DeleteGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Delete", 2); ok {
+		if r0, ok = rs[0].(*gcp.DeleteGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Delete returns *gcp.DeleteGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Delete returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.DeleteGroupOutput)
+	}
+
+	return r0, r1
+}
+
+// List records the call and returns the configured results.
+func (m *Service) List(ctx context.Context, input *gcp.ListGroupsInput) (*gcp.ListGroupsOutput, error) {
+	m.Record("List", ctx, input)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ListGroupsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("List", 2); ok {
+		if r0, ok = rs[0].(*gcp.ListGroupsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: List returns *gcp.ListGroupsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: List returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ListGroupsOutput)
+	}
+
+	return r0, r1
+}
+
+// ImportGKECluster records the call and returns the configured results.
+func (m *Service) ImportGKECluster(ctx context.Context, input *gcp.ImportGKEClusterInput) (*gcp.ImportGKEClusterOutput, error) {
+	m.Record("ImportGKECluster", ctx, input)
+	if m.ImportGKEClusterFunc != nil {
+		return m.ImportGKEClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ImportGKEClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ImportGKECluster", 2); ok {
+		if r0, ok = rs[0].(*gcp.ImportGKEClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ImportGKECluster returns *gcp.ImportGKEClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ImportGKECluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ImportGKEClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// Status records the call and returns the configured results.
+func (m *Service) Status(ctx context.Context, input *gcp.StatusGroupInput) (*gcp.StatusGroupOutput, error) {
+	m.Record("Status", ctx, input)
+	if m.StatusFunc != nil {
+		return m.StatusFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.StatusGroupOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Status", 2); ok {
+		if r0, ok = rs[0].(*gcp.StatusGroupOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Status returns *gcp.StatusGroupOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Status returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.StatusGroupOutput)
+	}
+
+	return r0, r1
+}
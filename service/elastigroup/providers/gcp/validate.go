@@ -0,0 +1,99 @@
+package gcp
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+)
+
+// Validate returns a validation.Errors listing the invalid fields of the
+// group, if any. Fields that are not set are not validated, so that partial
+// groups, e.g. updates, can be validated as well.
+func (o *Group) Validate() error {
+	return o.validate(false)
+}
+
+// ValidateCreate is like Validate, but also requires the fields that must be
+// set to create a group.
+func (o *Group) ValidateCreate() error {
+	return o.validate(true)
+}
+
+func (o *Group) validate(create bool) error {
+	if o == nil {
+		return nil
+	}
+
+	v := new(validation.Validator)
+	if create {
+		v.Required("name", o.Name)
+		v.Present("capacity", o.Capacity != nil)
+		v.Present("compute", o.Compute != nil)
+	}
+	if o.Capacity != nil {
+		v.Capacity("capacity", o.Capacity.Minimum, o.Capacity.Maximum, o.Capacity.Target)
+	}
+	o.Strategy.validate(v, "strategy")
+	if o.Compute != nil {
+		if create {
+			v.Present("compute.instanceTypes", o.Compute.InstanceTypes != nil)
+		}
+		o.Compute.InstanceTypes.validate(v, "compute.instanceTypes", create)
+	}
+	o.Scaling.validate(v, "scaling")
+
+	return v.Err()
+}
+
+func (o *Strategy) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	v.RangeInt(validation.Field(path, "preemptiblePercentage"), o.PreemptiblePercentage, 0, 100)
+	if o.OnDemandCount != nil {
+		if *o.OnDemandCount < 0 {
+			v.Errorf(validation.Field(path, "onDemandCount"), "must not be negative, got %d", *o.OnDemandCount)
+		}
+		if o.PreemptiblePercentage != nil {
+			v.Errorf(validation.Field(path, "onDemandCount"), "must not be set along with preemptiblePercentage")
+		}
+	}
+}
+
+func (o *InstanceTypes) validate(v *validation.Validator, path string, create bool) {
+	if o == nil {
+		return
+	}
+
+	if create {
+		v.Required(validation.Field(path, "ondemand"), o.OnDemand)
+		v.Present(validation.Field(path, "preemptible"), o.Preemptible != nil)
+	}
+	if (o.Preemptible != nil && len(o.Preemptible) == 0) || validation.IsNull(o.nullFields, "Preemptible") {
+		v.Errorf(validation.Field(path, "preemptible"), "must not be empty")
+	}
+}
+
+func (o *Scaling) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	validateScalingPolicies(v, validation.Field(path, "up"), o.Up)
+	validateScalingPolicies(v, validation.Field(path, "down"), o.Down)
+}
+
+func validateScalingPolicies(v *validation.Validator, path string, policies []*ScalingPolicy) {
+	for i, policy := range policies {
+		policy.validate(v, validation.Index(path, i))
+	}
+}
+
+func (o *ScalingPolicy) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	v.Required(validation.Field(path, "policyName"), o.PolicyName)
+	v.Required(validation.Field(path, "metricName"), o.MetricName)
+	v.Required(validation.Field(path, "namespace"), o.Namespace)
+}
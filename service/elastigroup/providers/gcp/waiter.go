@@ -0,0 +1,54 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// InstanceStatusRunning is the status of running instances, as reported by
+// Status.
+const InstanceStatusRunning = "RUNNING"
+
+// WaitUntilStable polls the group through svc until it runs as many instances
+// as its target capacity. GCP does not report instance health separately, so
+// running instances are considered healthy. It returns the last observed
+// stability, and a *waiter.StabilityError describing what is still missing if
+// the group did not become stable in time.
+func WaitUntilStable(ctx context.Context, svc Service, groupID string,
+	opts *waiter.Options) (*waiter.Stability, error) {
+	return waiter.PollStable(ctx, groupID, opts, func(ctx context.Context) (*waiter.Stability, error) {
+		return stability(ctx, svc, groupID)
+	})
+}
+
+func stability(ctx context.Context, svc Service, groupID string) (*waiter.Stability, error) {
+	group, err := svc.Read(ctx, &ReadGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := svc.Status(ctx, &StatusGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	st := new(waiter.Stability)
+	if group.Group != nil && group.Group.Capacity != nil {
+		st.Target = spotinst.IntValue(group.Group.Capacity.Target)
+	}
+
+	for _, i := range status.Instances {
+		name := spotinst.StringValue(i.InstanceName)
+		if strings.EqualFold(spotinst.StringValue(i.StatusName), InstanceStatusRunning) {
+			st.Running = append(st.Running, name)
+			st.Healthy = append(st.Healthy, name)
+		} else {
+			st.Pending = append(st.Pending, name)
+		}
+	}
+
+	return st, nil
+}
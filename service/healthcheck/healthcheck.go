@@ -35,6 +35,10 @@ type HealthCheck struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Check struct {
@@ -48,6 +52,7 @@ type Check struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListHealthChecksInput struct{}
@@ -197,10 +202,13 @@ func (s *ServiceOp) Update(ctx context.Context, input *UpdateHealthCheckInput) (
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.HealthCheck.ID = nil
+	in := *input
+	healthCheck := *input.HealthCheck
+	healthCheck.ID = nil
+	in.HealthCheck = &healthCheck
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -244,7 +252,12 @@ func (s *ServiceOp) Delete(ctx context.Context, input *DeleteHealthCheckInput) (
 func (o HealthCheck) MarshalJSON() ([]byte, error) {
 	type noMethod HealthCheck
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *HealthCheck) UnmarshalJSON(b []byte) error {
+	type noMethod HealthCheck
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *HealthCheck) SetId(v *string) *HealthCheck {
@@ -296,7 +309,12 @@ func (o *HealthCheck) SetProxyPort(v *int) *HealthCheck {
 func (o Check) MarshalJSON() ([]byte, error) {
 	type noMethod Check
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Check) UnmarshalJSON(b []byte) error {
+	type noMethod Check
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Check) SetProtocol(v *string) *Check {
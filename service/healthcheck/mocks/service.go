@@ -0,0 +1,164 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of healthcheck.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of healthcheck.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(context.Context, *healthcheck.ListHealthChecksInput) (*healthcheck.ListHealthChecksOutput, error)
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(context.Context, *healthcheck.CreateHealthCheckInput) (*healthcheck.CreateHealthCheckOutput, error)
+
+	// ReadFunc, if set, is called by Read.
+	ReadFunc func(context.Context, *healthcheck.ReadHealthCheckInput) (*healthcheck.ReadHealthCheckOutput, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(context.Context, *healthcheck.UpdateHealthCheckInput) (*healthcheck.UpdateHealthCheckOutput, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(context.Context, *healthcheck.DeleteHealthCheckInput) (*healthcheck.DeleteHealthCheckOutput, error)
+}
+
+var _ healthcheck.Service = (*Service)(nil)
+
+// List records the call and returns the configured results.
+func (m *Service) List(ctx context.Context, input *healthcheck.ListHealthChecksInput) (*healthcheck.ListHealthChecksOutput, error) {
+	m.Record("List", ctx, input)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, input)
+	}
+
+	var (
+		r0 *healthcheck.ListHealthChecksOutput
+		r1 error
+	)
+	if rs, ok := m.Result("List", 2); ok {
+		if r0, ok = rs[0].(*healthcheck.ListHealthChecksOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: List returns *healthcheck.ListHealthChecksOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: List returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(healthcheck.ListHealthChecksOutput)
+	}
+
+	return r0, r1
+}
+
+// Create records the call and returns the configured results.
+func (m *Service) Create(ctx context.Context, input *healthcheck.CreateHealthCheckInput) (*healthcheck.CreateHealthCheckOutput, error) {
+	m.Record("Create", ctx, input)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, input)
+	}
+
+	var (
+		r0 *healthcheck.CreateHealthCheckOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Create", 2); ok {
+		if r0, ok = rs[0].(*healthcheck.CreateHealthCheckOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Create returns *healthcheck.CreateHealthCheckOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Create returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(healthcheck.CreateHealthCheckOutput)
+	}
+
+	return r0, r1
+}
+
+// Read records the call and returns the configured results.
+func (m *Service) Read(ctx context.Context, input *healthcheck.ReadHealthCheckInput) (*healthcheck.ReadHealthCheckOutput, error) {
+	m.Record("Read", ctx, input)
+	if m.ReadFunc != nil {
+		return m.ReadFunc(ctx, input)
+	}
+
+	var (
+		r0 *healthcheck.ReadHealthCheckOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Read", 2); ok {
+		if r0, ok = rs[0].(*healthcheck.ReadHealthCheckOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Read returns *healthcheck.ReadHealthCheckOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Read returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(healthcheck.ReadHealthCheckOutput)
+	}
+
+	return r0, r1
+}
+
+// Update records the call and returns the configured results.
+func (m *Service) Update(ctx context.Context, input *healthcheck.UpdateHealthCheckInput) (*healthcheck.UpdateHealthCheckOutput, error) {
+	m.Record("Update", ctx, input)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, input)
+	}
+
+	var (
+		r0 *healthcheck.UpdateHealthCheckOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Update", 2); ok {
+		if r0, ok = rs[0].(*healthcheck.UpdateHealthCheckOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Update returns *healthcheck.UpdateHealthCheckOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Update returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(healthcheck.UpdateHealthCheckOutput)
+	}
+
+	return r0, r1
+}
+
+// Delete records the call and returns the configured results.
+func (m *Service) Delete(ctx context.Context, input *healthcheck.DeleteHealthCheckInput) (*healthcheck.DeleteHealthCheckOutput, error) {
+	m.Record("Delete", ctx, input)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, input)
+	}
+
+	var (
+		r0 *healthcheck.DeleteHealthCheckOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Delete", 2); ok {
+		if r0, ok = rs[0].(*healthcheck.DeleteHealthCheckOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Delete returns *healthcheck.DeleteHealthCheckOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Delete returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(healthcheck.DeleteHealthCheckOutput)
+	}
+
+	return r0, r1
+}
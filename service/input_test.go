@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	elastigroupazure "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	elastigroupgcp "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	managedinstanceaws "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/mcs"
+	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	oceangcp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestInputsUnchanged calls every method of every service with a populated
+// input, and verifies that the input is identical after the call, whether
+// the call succeeded or not.
+func TestInputsUnchanged(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	sess := srv.Session()
+	cfg := new(spotinst.Config).WithRetryPolicy(spotinst.NoRetryPolicy())
+
+	services := map[string]func(*session.Session, ...*spotinst.Config) interface{}{
+		"elastigroup/aws":     func(s *session.Session, c ...*spotinst.Config) interface{} { return elastigroupaws.New(s, c...) },
+		"elastigroup/azure":   func(s *session.Session, c ...*spotinst.Config) interface{} { return elastigroupazure.New(s, c...) },
+		"elastigroup/gcp":     func(s *session.Session, c ...*spotinst.Config) interface{} { return elastigroupgcp.New(s, c...) },
+		"ocean/aws":           func(s *session.Session, c ...*spotinst.Config) interface{} { return oceanaws.New(s, c...) },
+		"ocean/gcp":           func(s *session.Session, c ...*spotinst.Config) interface{} { return oceangcp.New(s, c...) },
+		"managedinstance/aws": func(s *session.Session, c ...*spotinst.Config) interface{} { return managedinstanceaws.New(s, c...) },
+		"mrscaler":            func(s *session.Session, c ...*spotinst.Config) interface{} { return mrscaler.New(s, c...) },
+		"multai":              func(s *session.Session, c ...*spotinst.Config) interface{} { return multai.New(s, c...) },
+		"healthcheck":         func(s *session.Session, c ...*spotinst.Config) interface{} { return healthcheck.New(s, c...) },
+		"subscription":        func(s *session.Session, c ...*spotinst.Config) interface{} { return subscription.New(s, c...) },
+		"mcs":                 func(s *session.Session, c ...*spotinst.Config) interface{} { return mcs.New(s, c...) },
+	}
+
+	ctxType := reflect.TypeOf((*context.Context)(nil)).Elem()
+
+	for name, newService := range services {
+		svc := reflect.ValueOf(newService(sess, cfg))
+		for i := 0; i < svc.NumMethod(); i++ {
+			method := svc.Type().Method(i)
+			mt := method.Type
+
+			// Only API operations, e.g. Read(ctx, *ReadGroupInput).
+			if mt.NumIn() != 3 || mt.In(1) != ctxType || mt.In(2).Kind() != reflect.Ptr ||
+				!strings.HasSuffix(mt.In(2).Elem().Name(), "Input") {
+				continue
+			}
+
+			t.Run(name+"/"+method.Name, func(t *testing.T) {
+				// Populated alike, as inputs have no DeepCopy method.
+				input, want := reflect.New(mt.In(2).Elem()), reflect.New(mt.In(2).Elem())
+				populate(input.Elem(), 0)
+				populate(want.Elem(), 0)
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				svc.Method(i).Call([]reflect.Value{reflect.ValueOf(ctx), input})
+
+				assert.Equal(t, want.Interface(), input.Interface(), "expected the input to be unchanged")
+			})
+		}
+	}
+}
+
+// maxPopulateDepth bounds the depth of populated values, as some types are
+// recursive.
+const maxPopulateDepth = 6
+
+// populate sets the exported fields of v to non-zero values, recursively.
+func populate(v reflect.Value, depth int) {
+	if depth > maxPopulateDepth {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		p := reflect.New(v.Type().Elem())
+		populate(p.Elem(), depth+1)
+		v.Set(p)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				populate(v.Field(i), depth+1)
+			}
+		}
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 1, 1)
+		populate(s.Index(0), depth+1)
+		v.Set(s)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		key := reflect.New(v.Type().Key()).Elem()
+		elem := reflect.New(v.Type().Elem()).Elem()
+		populate(key, depth+1)
+		populate(elem, depth+1)
+		m.SetMapIndex(key, elem)
+		v.Set(m)
+	case reflect.String:
+		v.SetString("test-" + strings.ToLower(v.Type().Name()))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	}
+}
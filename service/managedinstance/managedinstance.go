@@ -1,5 +1,7 @@
 package managedinstance
 
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen
+
 import (
 	"github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -0,0 +1,46 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of managedinstance.Service.
+package mocks
+
+import (
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/managedinstance"
+	"github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of managedinstance.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. CloudProviderAWSFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// CloudProviderAWSFunc, if set, is called by CloudProviderAWS.
+	CloudProviderAWSFunc func() aws.Service
+}
+
+var _ managedinstance.Service = (*Service)(nil)
+
+// CloudProviderAWS records the call and returns the configured results.
+func (m *Service) CloudProviderAWS() aws.Service {
+	m.Record("CloudProviderAWS")
+	if m.CloudProviderAWSFunc != nil {
+		return m.CloudProviderAWSFunc()
+	}
+
+	var (
+		r0 aws.Service
+	)
+	if rs, ok := m.Result("CloudProviderAWS", 1); ok {
+		if r0, ok = rs[0].(aws.Service); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CloudProviderAWS returns aws.Service as result 0, got canned value of type %T", rs[0]))
+		}
+	}
+
+	return r0
+}
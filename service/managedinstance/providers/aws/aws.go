@@ -90,6 +90,10 @@ type ManagedInstance struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Compute struct {
@@ -102,6 +106,7 @@ type Compute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LaunchSpecification struct {
@@ -121,6 +126,7 @@ type LaunchSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type CreditSpecification struct {
@@ -128,6 +134,7 @@ type CreditSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type NetworkInterface struct {
@@ -138,6 +145,7 @@ type NetworkInterface struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type IAMInstanceProfile struct {
@@ -146,6 +154,7 @@ type IAMInstanceProfile struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceTypes struct {
@@ -154,6 +163,7 @@ type InstanceTypes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Strategy struct {
@@ -167,6 +177,7 @@ type Strategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RevertToSpot struct {
@@ -174,6 +185,7 @@ type RevertToSpot struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Scheduling struct {
@@ -181,6 +193,7 @@ type Scheduling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Task struct {
@@ -192,6 +205,7 @@ type Task struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Persistence struct {
@@ -202,6 +216,7 @@ type Persistence struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type HealthCheck struct {
@@ -212,6 +227,7 @@ type HealthCheck struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Integration struct {
@@ -220,6 +236,7 @@ type Integration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Route53Integration struct {
@@ -227,6 +244,7 @@ type Route53Integration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Domain struct {
@@ -236,6 +254,7 @@ type Domain struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type RecordSet struct {
@@ -244,6 +263,7 @@ type RecordSet struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LoadBalancersConfig struct {
@@ -251,6 +271,7 @@ type LoadBalancersConfig struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LoadBalancer struct {
@@ -264,6 +285,7 @@ type LoadBalancer struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListManagedInstancesInput struct{}
@@ -355,6 +377,10 @@ func (s *ServiceOp) List(ctx context.Context, input *ListManagedInstancesInput)
 }
 
 func (s *ServiceOp) Create(ctx context.Context, input *CreateManagedInstanceInput) (*CreateManagedInstanceOutput, error) {
+	if err := s.Client.ValidateCreate(input.ManagedInstance); err != nil {
+		return nil, err
+	}
+
 	r := client.NewRequest(http.MethodPost, "/aws/ec2/managedInstance")
 	r.Obj = input
 
@@ -406,6 +432,10 @@ func (s *ServiceOp) Read(ctx context.Context, input *ReadManagedInstanceInput) (
 }
 
 func (s *ServiceOp) Update(ctx context.Context, input *UpdateManagedInstanceInput) (*UpdateManagedInstanceOutput, error) {
+	if err := s.Client.Validate(input.ManagedInstance); err != nil {
+		return nil, err
+	}
+
 	path, err := uritemplates.Expand("/aws/ec2/managedInstance/{managedInstanceId}", uritemplates.Values{
 		"managedInstanceId": spotinst.StringValue(input.ManagedInstance.ID),
 	})
@@ -414,10 +444,13 @@ func (s *ServiceOp) Update(ctx context.Context, input *UpdateManagedInstanceInpu
 	}
 
 	// We do NOT need the ID anymore, so let's drop it.
-	input.ManagedInstance.ID = nil
+	in := *input
+	managedInstance := *input.ManagedInstance
+	managedInstance.ID = nil
+	in.ManagedInstance = &managedInstance
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	if input.AutoApplyTags != nil {
 		r.Params.Set("autoApplyTags",
@@ -467,7 +500,12 @@ func (s *ServiceOp) Delete(ctx context.Context, input *DeleteManagedInstanceInpu
 func (o ManagedInstance) MarshalJSON() ([]byte, error) {
 	type noMethod ManagedInstance
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ManagedInstance) UnmarshalJSON(b []byte) error {
+	type noMethod ManagedInstance
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ManagedInstance) SetId(v *string) *ManagedInstance {
@@ -547,7 +585,12 @@ func (o *ManagedInstance) SetHealthCheck(v *HealthCheck) *ManagedInstance {
 func (o Integration) MarshalJSON() ([]byte, error) {
 	type noMethod Integration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Integration) UnmarshalJSON(b []byte) error {
+	type noMethod Integration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Integration) SetRoute53(v *Route53Integration) *Integration {
@@ -571,7 +614,12 @@ func (o *Integration) SetLoadBalancersConfig(v *LoadBalancersConfig) *Integratio
 func (o Route53Integration) MarshalJSON() ([]byte, error) {
 	type noMethod Route53Integration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Route53Integration) UnmarshalJSON(b []byte) error {
+	type noMethod Route53Integration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Route53Integration) SetDomains(v []*Domain) *Route53Integration {
@@ -588,7 +636,12 @@ func (o *Route53Integration) SetDomains(v []*Domain) *Route53Integration {
 func (o Domain) MarshalJSON() ([]byte, error) {
 	type noMethod Domain
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Domain) UnmarshalJSON(b []byte) error {
+	type noMethod Domain
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Domain) SetHostedZoneId(v *string) *Domain {
@@ -619,7 +672,12 @@ func (o *Domain) SetRecordSets(v []*RecordSet) *Domain {
 func (o RecordSet) MarshalJSON() ([]byte, error) {
 	type noMethod RecordSet
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RecordSet) UnmarshalJSON(b []byte) error {
+	type noMethod RecordSet
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RecordSet) SetName(v *string) *RecordSet {
@@ -643,7 +701,12 @@ func (o *RecordSet) SetUsePublicIP(v *bool) *RecordSet {
 func (o LoadBalancersConfig) MarshalJSON() ([]byte, error) {
 	type noMethod LoadBalancersConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LoadBalancersConfig) UnmarshalJSON(b []byte) error {
+	type noMethod LoadBalancersConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LoadBalancersConfig) SetLoadBalancers(v []*LoadBalancer) *LoadBalancersConfig {
@@ -660,7 +723,12 @@ func (o *LoadBalancersConfig) SetLoadBalancers(v []*LoadBalancer) *LoadBalancers
 func (o LoadBalancer) MarshalJSON() ([]byte, error) {
 	type noMethod LoadBalancer
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LoadBalancer) UnmarshalJSON(b []byte) error {
+	type noMethod LoadBalancer
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LoadBalancer) SetName(v *string) *LoadBalancer {
@@ -719,7 +787,12 @@ func (o *LoadBalancer) SetAutoWeight(v *bool) *LoadBalancer {
 func (o Scheduling) MarshalJSON() ([]byte, error) {
 	type noMethod Scheduling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scheduling) UnmarshalJSON(b []byte) error {
+	type noMethod Scheduling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
@@ -736,7 +809,12 @@ func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
 func (o Task) MarshalJSON() ([]byte, error) {
 	type noMethod Task
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Task) UnmarshalJSON(b []byte) error {
+	type noMethod Task
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Task) SetIsEnabled(v *bool) *Task {
@@ -781,7 +859,12 @@ func (o *Task) SetStartTime(v *string) *Task {
 func (o Compute) MarshalJSON() ([]byte, error) {
 	type noMethod Compute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Compute) UnmarshalJSON(b []byte) error {
+	type noMethod Compute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Compute) SetLaunchSpecification(v *LaunchSpecification) *Compute {
@@ -835,7 +918,12 @@ func (o *Compute) SetVpcId(v *string) *Compute {
 func (o LaunchSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod LaunchSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LaunchSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod LaunchSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LaunchSpecification) SetMonitoring(v *bool) *LaunchSpecification {
@@ -936,7 +1024,12 @@ func (o *LaunchSpecification) SetTags(v []*Tag) *LaunchSpecification {
 func (o NetworkInterface) MarshalJSON() ([]byte, error) {
 	type noMethod NetworkInterface
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NetworkInterface) UnmarshalJSON(b []byte) error {
+	type noMethod NetworkInterface
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *NetworkInterface) SetDeviceIndex(v *int) *NetworkInterface {
@@ -974,7 +1067,12 @@ func (o *NetworkInterface) SetAssociateIPV6Address(v *bool) *NetworkInterface {
 func (o CreditSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod CreditSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *CreditSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod CreditSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *CreditSpecification) SetCPUCredits(v *string) *CreditSpecification {
@@ -991,7 +1089,12 @@ func (o *CreditSpecification) SetCPUCredits(v *string) *CreditSpecification {
 func (o IAMInstanceProfile) MarshalJSON() ([]byte, error) {
 	type noMethod IAMInstanceProfile
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *IAMInstanceProfile) UnmarshalJSON(b []byte) error {
+	type noMethod IAMInstanceProfile
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *IAMInstanceProfile) SetName(v *string) *IAMInstanceProfile {
@@ -1015,7 +1118,12 @@ func (o *IAMInstanceProfile) SetArn(v *string) *IAMInstanceProfile {
 func (o InstanceTypes) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceTypes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceTypes) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceTypes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceTypes) SetPreferredType(v *string) *InstanceTypes {
@@ -1039,7 +1147,12 @@ func (o *InstanceTypes) SetInstanceTypes(v []string) *InstanceTypes {
 func (o HealthCheck) MarshalJSON() ([]byte, error) {
 	type noMethod HealthCheck
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *HealthCheck) UnmarshalJSON(b []byte) error {
+	type noMethod HealthCheck
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *HealthCheck) SetGracePeriod(v *int) *HealthCheck {
@@ -1077,7 +1190,12 @@ func (o *HealthCheck) SetAutoHealing(v *bool) *HealthCheck {
 func (o Persistence) MarshalJSON() ([]byte, error) {
 	type noMethod Persistence
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Persistence) UnmarshalJSON(b []byte) error {
+	type noMethod Persistence
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Persistence) SetBlockDevicesMode(v *string) *Persistence {
@@ -1115,7 +1233,12 @@ func (o *Persistence) SetShouldPersistBlockDevices(v *bool) *Persistence {
 func (o Strategy) MarshalJSON() ([]byte, error) {
 	type noMethod Strategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Strategy) UnmarshalJSON(b []byte) error {
+	type noMethod Strategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Strategy) SetDrainingTimeout(v *int) *Strategy {
@@ -1174,7 +1297,12 @@ func (o *Strategy) SetLifeCycle(v *string) *Strategy {
 func (o RevertToSpot) MarshalJSON() ([]byte, error) {
 	type noMethod RevertToSpot
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RevertToSpot) UnmarshalJSON(b []byte) error {
+	type noMethod RevertToSpot
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RevertToSpot) SetPerformAt(v *string) *RevertToSpot {
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagedInstanceValidate(t *testing.T) {
+	instance := &ManagedInstance{
+		Strategy: &Strategy{DrainingTimeout: spotinst.Int(-1)},
+		Compute: &Compute{
+			LaunchSpecification: &LaunchSpecification{
+				InstanceTypes: &InstanceTypes{
+					Types:         []string{"t3.large", "m5.large"},
+					PreferredType: spotinst.String("c5.large"),
+				},
+			},
+		},
+	}
+
+	err := instance.Validate()
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"strategy.drainingTimeout: must not be negative, got -1; "+
+		"compute.launchSpecification.instanceTypes.preferredType: must be one of types, got \"c5.large\"")
+
+	// Partial managed instances, e.g. updates, are valid.
+	partial := &ManagedInstance{
+		Compute: &Compute{
+			LaunchSpecification: &LaunchSpecification{
+				InstanceTypes: &InstanceTypes{PreferredType: spotinst.String("c5.large")},
+			},
+		},
+	}
+	assert.NoError(t, partial.Validate())
+	assert.NoError(t, (*ManagedInstance)(nil).Validate())
+
+	// Creates require the mandatory fields.
+	instance = &ManagedInstance{
+		Name:   spotinst.String("foo"),
+		Region: spotinst.String("us-west-2"),
+		Compute: &Compute{
+			Product:             spotinst.String("Linux/UNIX"),
+			LaunchSpecification: &LaunchSpecification{InstanceTypes: &InstanceTypes{}},
+		},
+	}
+	assert.NoError(t, instance.Validate())
+	assert.EqualError(t, instance.ValidateCreate(), "spotinst: invalid resource: compute.launchSpecification.instanceTypes.types: must be set")
+
+	instance.Compute.LaunchSpecification.InstanceTypes.SetInstanceTypes([]string{"t3.large"})
+	assert.NoError(t, instance.ValidateCreate())
+
+	instance.Compute.LaunchSpecification = nil
+	assert.EqualError(t, instance.ValidateCreate(), "spotinst: invalid resource: compute.launchSpecification: must be set")
+}
+
+func TestManagedInstanceValidation(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	var updates int
+	srv.Handle(http.MethodPut, "/aws/ec2/managedInstance/{managedInstanceId}",
+		func(req *http.Request, body []byte) ([]interface{}, error) {
+			updates++
+			return []interface{}{map[string]interface{}{"id": "smi-1"}}, nil
+		})
+
+	svc := New(srv.Session(), new(spotinst.Config).WithValidation(true))
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, &CreateManagedInstanceInput{ManagedInstance: &ManagedInstance{Strategy: &Strategy{DrainingTimeout: spotinst.Int(-1)}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"name: must be set; region: must be set; compute: must be set; "+
+		"strategy.drainingTimeout: must not be negative, got -1")
+
+	instanceTypes := new(InstanceTypes)
+	instanceTypes.SetInstanceTypes(nil)
+	invalid := &ManagedInstance{
+		ID:      spotinst.String("smi-1"),
+		Compute: &Compute{LaunchSpecification: &LaunchSpecification{InstanceTypes: instanceTypes}},
+	}
+	_, err = svc.Update(ctx, &UpdateManagedInstanceInput{ManagedInstance: invalid})
+	assert.EqualError(t, err, "spotinst: invalid resource: compute.launchSpecification.instanceTypes.types: must not be empty")
+	assert.Equal(t, 0, updates)
+
+	// Partial updates are valid.
+	_, err = svc.Update(ctx, &UpdateManagedInstanceInput{ManagedInstance: &ManagedInstance{ID: spotinst.String("smi-1"), Description: spotinst.String("foo")}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updates)
+}
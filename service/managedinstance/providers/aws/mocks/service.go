@@ -0,0 +1,164 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of aws.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of aws.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(context.Context, *aws.ListManagedInstancesInput) (*aws.ListManagedInstancesOutput, error)
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(context.Context, *aws.CreateManagedInstanceInput) (*aws.CreateManagedInstanceOutput, error)
+
+	// ReadFunc, if set, is called by Read.
+	ReadFunc func(context.Context, *aws.ReadManagedInstanceInput) (*aws.ReadManagedInstanceOutput, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(context.Context, *aws.UpdateManagedInstanceInput) (*aws.UpdateManagedInstanceOutput, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(context.Context, *aws.DeleteManagedInstanceInput) (*aws.DeleteManagedInstanceOutput, error)
+}
+
+var _ aws.Service = (*Service)(nil)
+
+// List records the call and returns the configured results.
+func (m *Service) List(ctx context.Context, input *aws.ListManagedInstancesInput) (*aws.ListManagedInstancesOutput, error) {
+	m.Record("List", ctx, input)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ListManagedInstancesOutput
+		r1 error
+	)
+	if rs, ok := m.Result("List", 2); ok {
+		if r0, ok = rs[0].(*aws.ListManagedInstancesOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: List returns *aws.ListManagedInstancesOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: List returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ListManagedInstancesOutput)
+	}
+
+	return r0, r1
+}
+
+// Create records the call and returns the configured results.
+func (m *Service) Create(ctx context.Context, input *aws.CreateManagedInstanceInput) (*aws.CreateManagedInstanceOutput, error) {
+	m.Record("Create", ctx, input)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.CreateManagedInstanceOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Create", 2); ok {
+		if r0, ok = rs[0].(*aws.CreateManagedInstanceOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Create returns *aws.CreateManagedInstanceOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Create returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.CreateManagedInstanceOutput)
+	}
+
+	return r0, r1
+}
+
+// Read records the call and returns the configured results.
+func (m *Service) Read(ctx context.Context, input *aws.ReadManagedInstanceInput) (*aws.ReadManagedInstanceOutput, error) {
+	m.Record("Read", ctx, input)
+	if m.ReadFunc != nil {
+		return m.ReadFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadManagedInstanceOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Read", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadManagedInstanceOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Read returns *aws.ReadManagedInstanceOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Read returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadManagedInstanceOutput)
+	}
+
+	return r0, r1
+}
+
+// Update records the call and returns the configured results.
+func (m *Service) Update(ctx context.Context, input *aws.UpdateManagedInstanceInput) (*aws.UpdateManagedInstanceOutput, error) {
+	m.Record("Update", ctx, input)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.UpdateManagedInstanceOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Update", 2); ok {
+		if r0, ok = rs[0].(*aws.UpdateManagedInstanceOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Update returns *aws.UpdateManagedInstanceOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Update returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.UpdateManagedInstanceOutput)
+	}
+
+	return r0, r1
+}
+
+// Delete records the call and returns the configured results.
+func (m *Service) Delete(ctx context.Context, input *aws.DeleteManagedInstanceInput) (*aws.DeleteManagedInstanceOutput, error) {
+	m.Record("Delete", ctx, input)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DeleteManagedInstanceOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Delete", 2); ok {
+		if r0, ok = rs[0].(*aws.DeleteManagedInstanceOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Delete returns *aws.DeleteManagedInstanceOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Delete returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DeleteManagedInstanceOutput)
+	}
+
+	return r0, r1
+}
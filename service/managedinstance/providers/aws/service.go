@@ -1,5 +1,8 @@
 package aws
 
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/deepcopygen
+
 import (
 	"context"
 
@@ -31,6 +34,6 @@ func New(sess *session.Session, cfgs ...*spotinst.Config) *ServiceOp {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
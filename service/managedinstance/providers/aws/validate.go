@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+)
+
+// Validate returns a validation.Errors listing the invalid fields of the
+// managed instance, if any. Fields that are not set are not validated, so
+// that partial managed instances, e.g. updates, can be validated as well.
+func (o *ManagedInstance) Validate() error {
+	return o.validate(false)
+}
+
+// ValidateCreate is like Validate, but also requires the fields that must be
+// set to create a managed instance.
+func (o *ManagedInstance) ValidateCreate() error {
+	return o.validate(true)
+}
+
+func (o *ManagedInstance) validate(create bool) error {
+	if o == nil {
+		return nil
+	}
+
+	v := new(validation.Validator)
+	if create {
+		v.Required("name", o.Name)
+		v.Required("region", o.Region)
+		v.Present("compute", o.Compute != nil)
+		if o.Compute != nil {
+			v.Required("compute.product", o.Compute.Product)
+			v.Present("compute.launchSpecification", o.Compute.LaunchSpecification != nil)
+		}
+		if o.Compute != nil && o.Compute.LaunchSpecification != nil {
+			v.Present("compute.launchSpecification.instanceTypes", o.Compute.LaunchSpecification.InstanceTypes != nil)
+		}
+	}
+	if o.Strategy != nil && o.Strategy.DrainingTimeout != nil && *o.Strategy.DrainingTimeout < 0 {
+		v.Errorf("strategy.drainingTimeout", "must not be negative, got %d", *o.Strategy.DrainingTimeout)
+	}
+	if o.Compute != nil && o.Compute.LaunchSpecification != nil {
+		o.Compute.LaunchSpecification.InstanceTypes.validate(v, "compute.launchSpecification.instanceTypes", create)
+	}
+
+	return v.Err()
+}
+
+func (o *InstanceTypes) validate(v *validation.Validator, path string, create bool) {
+	if o == nil {
+		return
+	}
+
+	if create {
+		v.Present(validation.Field(path, "types"), o.Types != nil)
+	}
+
+	if (o.Types != nil && len(o.Types) == 0) || validation.IsNull(o.nullFields, "Types") {
+		v.Errorf(validation.Field(path, "types"), "must not be empty")
+	}
+	if o.PreferredType != nil && len(o.Types) > 0 {
+		for _, t := range o.Types {
+			if t == *o.PreferredType {
+				return
+			}
+		}
+		v.Errorf(validation.Field(path, "preferredType"), "must be one of types, got %q", *o.PreferredType)
+	}
+}
@@ -0,0 +1,52 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of mcs.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/mcs"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of mcs.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. GetClusterCostsFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// GetClusterCostsFunc, if set, is called by GetClusterCosts.
+	GetClusterCostsFunc func(context.Context, *mcs.ClusterCostInput) (*mcs.ClusterCostOutput, error)
+}
+
+var _ mcs.Service = (*Service)(nil)
+
+// GetClusterCosts records the call and returns the configured results.
+func (m *Service) GetClusterCosts(ctx context.Context, input *mcs.ClusterCostInput) (*mcs.ClusterCostOutput, error) {
+	m.Record("GetClusterCosts", ctx, input)
+	if m.GetClusterCostsFunc != nil {
+		return m.GetClusterCostsFunc(ctx, input)
+	}
+
+	var (
+		r0 *mcs.ClusterCostOutput
+		r1 error
+	)
+	if rs, ok := m.Result("GetClusterCosts", 2); ok {
+		if r0, ok = rs[0].(*mcs.ClusterCostOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: GetClusterCosts returns *mcs.ClusterCostOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: GetClusterCosts returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(mcs.ClusterCostOutput)
+	}
+
+	return r0, r1
+}
@@ -1,5 +1,7 @@
 package mcs
 
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen
+
 import (
 	"context"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -26,6 +28,6 @@ func New(sess *session.Session, cfgs ...*spotinst.Config) *ServiceOp {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
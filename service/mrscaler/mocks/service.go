@@ -0,0 +1,192 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of mrscaler.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of mrscaler.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListFunc, if set, is called by List.
+	ListFunc func(context.Context, *mrscaler.ListScalersInput) (*mrscaler.ListScalersOutput, error)
+
+	// CreateFunc, if set, is called by Create.
+	CreateFunc func(context.Context, *mrscaler.CreateScalerInput) (*mrscaler.CreateScalerOutput, error)
+
+	// ReadFunc, if set, is called by Read.
+	ReadFunc func(context.Context, *mrscaler.ReadScalerInput) (*mrscaler.ReadScalerOutput, error)
+
+	// ReadScalerClusterFunc, if set, is called by ReadScalerCluster.
+	ReadScalerClusterFunc func(context.Context, *mrscaler.ScalerClusterStatusInput) (*mrscaler.ScalerClusterStatusOutput, error)
+
+	// UpdateFunc, if set, is called by Update.
+	UpdateFunc func(context.Context, *mrscaler.UpdateScalerInput) (*mrscaler.UpdateScalerOutput, error)
+
+	// DeleteFunc, if set, is called by Delete.
+	DeleteFunc func(context.Context, *mrscaler.DeleteScalerInput) (*mrscaler.DeleteScalerOutput, error)
+}
+
+var _ mrscaler.Service = (*Service)(nil)
+
+// List records the call and returns the configured results.
+func (m *Service) List(ctx context.Context, input *mrscaler.ListScalersInput) (*mrscaler.ListScalersOutput, error) {
+	m.Record("List", ctx, input)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, input)
+	}
+
+	var (
+		r0 *mrscaler.ListScalersOutput
+		r1 error
+	)
+	if rs, ok := m.Result("List", 2); ok {
+		if r0, ok = rs[0].(*mrscaler.ListScalersOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: List returns *mrscaler.ListScalersOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: List returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(mrscaler.ListScalersOutput)
+	}
+
+	return r0, r1
+}
+
+// Create records the call and returns the configured results.
+func (m *Service) Create(ctx context.Context, input *mrscaler.CreateScalerInput) (*mrscaler.CreateScalerOutput, error) {
+	m.Record("Create", ctx, input)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, input)
+	}
+
+	var (
+		r0 *mrscaler.CreateScalerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Create", 2); ok {
+		if r0, ok = rs[0].(*mrscaler.CreateScalerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Create returns *mrscaler.CreateScalerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Create returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(mrscaler.CreateScalerOutput)
+	}
+
+	return r0, r1
+}
+
+// Read records the call and returns the configured results.
+func (m *Service) Read(ctx context.Context, input *mrscaler.ReadScalerInput) (*mrscaler.ReadScalerOutput, error) {
+	m.Record("Read", ctx, input)
+	if m.ReadFunc != nil {
+		return m.ReadFunc(ctx, input)
+	}
+
+	var (
+		r0 *mrscaler.ReadScalerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Read", 2); ok {
+		if r0, ok = rs[0].(*mrscaler.ReadScalerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Read returns *mrscaler.ReadScalerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Read returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(mrscaler.ReadScalerOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadScalerCluster records the call and returns the configured results.
+func (m *Service) ReadScalerCluster(ctx context.Context, input *mrscaler.ScalerClusterStatusInput) (*mrscaler.ScalerClusterStatusOutput, error) {
+	m.Record("ReadScalerCluster", ctx, input)
+	if m.ReadScalerClusterFunc != nil {
+		return m.ReadScalerClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *mrscaler.ScalerClusterStatusOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadScalerCluster", 2); ok {
+		if r0, ok = rs[0].(*mrscaler.ScalerClusterStatusOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadScalerCluster returns *mrscaler.ScalerClusterStatusOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadScalerCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(mrscaler.ScalerClusterStatusOutput)
+	}
+
+	return r0, r1
+}
+
+// Update records the call and returns the configured results.
+func (m *Service) Update(ctx context.Context, input *mrscaler.UpdateScalerInput) (*mrscaler.UpdateScalerOutput, error) {
+	m.Record("Update", ctx, input)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, input)
+	}
+
+	var (
+		r0 *mrscaler.UpdateScalerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Update", 2); ok {
+		if r0, ok = rs[0].(*mrscaler.UpdateScalerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Update returns *mrscaler.UpdateScalerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Update returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(mrscaler.UpdateScalerOutput)
+	}
+
+	return r0, r1
+}
+
+// Delete records the call and returns the configured results.
+func (m *Service) Delete(ctx context.Context, input *mrscaler.DeleteScalerInput) (*mrscaler.DeleteScalerOutput, error) {
+	m.Record("Delete", ctx, input)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, input)
+	}
+
+	var (
+		r0 *mrscaler.DeleteScalerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Delete", 2); ok {
+		if r0, ok = rs[0].(*mrscaler.DeleteScalerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Delete returns *mrscaler.DeleteScalerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Delete returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(mrscaler.DeleteScalerOutput)
+	}
+
+	return r0, r1
+}
@@ -68,6 +68,10 @@ type Scaler struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Strategy struct {
@@ -78,6 +82,7 @@ type Strategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Cloning struct {
@@ -86,6 +91,7 @@ type Cloning struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Wrapping struct {
@@ -93,6 +99,7 @@ type Wrapping struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type CreateNew struct {
@@ -101,6 +108,7 @@ type CreateNew struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ProvisioningTimeout struct {
@@ -109,6 +117,7 @@ type ProvisioningTimeout struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Compute struct {
@@ -132,6 +141,7 @@ type Compute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Cluster struct {
@@ -146,6 +156,7 @@ type Cluster struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AvailabilityZone struct {
@@ -154,6 +165,7 @@ type AvailabilityZone struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Tag struct {
@@ -162,6 +174,7 @@ type Tag struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Application struct {
@@ -171,6 +184,7 @@ type Application struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceWeight struct {
@@ -179,6 +193,7 @@ type InstanceWeight struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceGroups struct {
@@ -188,6 +203,7 @@ type InstanceGroups struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceGroup struct {
@@ -199,6 +215,7 @@ type InstanceGroup struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceGroupCapacity struct {
@@ -208,6 +225,7 @@ type InstanceGroupCapacity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type EBSConfiguration struct {
@@ -216,6 +234,7 @@ type EBSConfiguration struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BlockDeviceConfig struct {
@@ -224,6 +243,7 @@ type BlockDeviceConfig struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type VolumeSpecification struct {
@@ -233,6 +253,7 @@ type VolumeSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Scaling struct {
@@ -241,6 +262,7 @@ type Scaling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ScalingPolicy struct {
@@ -259,6 +281,7 @@ type ScalingPolicy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Action struct {
@@ -272,6 +295,7 @@ type Action struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Dimension struct {
@@ -280,6 +304,7 @@ type Dimension struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Configurations struct {
@@ -287,6 +312,7 @@ type Configurations struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BootstrapActions struct {
@@ -294,6 +320,7 @@ type BootstrapActions struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Steps struct {
@@ -301,6 +328,7 @@ type Steps struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type S3File struct {
@@ -309,6 +337,7 @@ type S3File struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Scheduling struct {
@@ -316,6 +345,7 @@ type Scheduling struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Task struct {
@@ -329,6 +359,7 @@ type Task struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListScalersInput struct{}
@@ -454,7 +485,12 @@ func scalerClustersFromHttpResponse(resp *http.Response) ([]*ScalerCluster, erro
 func (o Scaler) MarshalJSON() ([]byte, error) {
 	type noMethod Scaler
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scaler) UnmarshalJSON(b []byte) error {
+	type noMethod Scaler
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scaler) SetId(v *string) *Scaler {
@@ -528,7 +564,12 @@ func (o *Scaler) SetCoreScaling(v *Scaling) *Scaler {
 func (o Cluster) MarshalJSON() ([]byte, error) {
 	type noMethod Cluster
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Cluster) UnmarshalJSON(b []byte) error {
+	type noMethod Cluster
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetLogURI sets the log uri when creating a new cluster
@@ -602,7 +643,12 @@ func (o *Cluster) SetKeepJobFlowAliveWhenNoSteps(v *bool) *Cluster {
 func (o Scheduling) MarshalJSON() ([]byte, error) {
 	type noMethod Scheduling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scheduling) UnmarshalJSON(b []byte) error {
+	type noMethod Scheduling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
@@ -619,7 +665,12 @@ func (o *Scheduling) SetTasks(v []*Task) *Scheduling {
 func (o Task) MarshalJSON() ([]byte, error) {
 	type noMethod Task
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Task) UnmarshalJSON(b []byte) error {
+	type noMethod Task
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Task) SetIsEnabled(v *bool) *Task {
@@ -679,7 +730,12 @@ func (o *Task) SetMaxCapacity(v *int) *Task {
 func (o Strategy) MarshalJSON() ([]byte, error) {
 	type noMethod Strategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Strategy) UnmarshalJSON(b []byte) error {
+	type noMethod Strategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Strategy) SetCloning(v *Cloning) *Strategy {
@@ -719,7 +775,12 @@ func (o *Strategy) SetProvisioningTimeout(v *ProvisioningTimeout) *Strategy {
 func (o Cloning) MarshalJSON() ([]byte, error) {
 	type noMethod Cloning
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Cloning) UnmarshalJSON(b []byte) error {
+	type noMethod Cloning
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Cloning) SetOriginClusterId(v *string) *Cloning {
@@ -744,7 +805,12 @@ func (o *Cloning) SetRetries(v *int) *Cloning {
 func (o Wrapping) MarshalJSON() ([]byte, error) {
 	type noMethod Wrapping
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Wrapping) UnmarshalJSON(b []byte) error {
+	type noMethod Wrapping
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Wrapping) SetSourceClusterId(v *string) *Wrapping {
@@ -761,7 +827,12 @@ func (o *Wrapping) SetSourceClusterId(v *string) *Wrapping {
 func (o CreateNew) MarshalJSON() ([]byte, error) {
 	type noMethod CreateNew
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *CreateNew) UnmarshalJSON(b []byte) error {
+	type noMethod CreateNew
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetReleaseLabel sets the release label for a new scaler
@@ -787,7 +858,12 @@ func (o *CreateNew) SetRetries(v *int) *CreateNew {
 func (o ProvisioningTimeout) MarshalJSON() ([]byte, error) {
 	type noMethod ProvisioningTimeout
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ProvisioningTimeout) UnmarshalJSON(b []byte) error {
+	type noMethod ProvisioningTimeout
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetTimeout sets the amount of time in seconds to wait for a scaler to be provisioned
@@ -817,7 +893,12 @@ func (o *ProvisioningTimeout) SetTimeoutAction(v *string) *ProvisioningTimeout {
 func (o Compute) MarshalJSON() ([]byte, error) {
 	type noMethod Compute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Compute) UnmarshalJSON(b []byte) error {
+	type noMethod Compute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Compute) SetAvailabilityZones(v []*AvailabilityZone) *Compute {
@@ -958,7 +1039,12 @@ func (o *Compute) SetInstanceWeights(v []*InstanceWeight) *Compute {
 func (o Application) MarshalJSON() ([]byte, error) {
 	type noMethod Application
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Application) UnmarshalJSON(b []byte) error {
+	type noMethod Application
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetArgs sets the list of args to use with the application
@@ -992,7 +1078,12 @@ func (o *Application) SetVersion(v *string) *Application {
 func (o InstanceWeight) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceWeight
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceWeight) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceWeight
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceWeight) SetInstanceType(v *string) *InstanceWeight {
@@ -1016,7 +1107,12 @@ func (o *InstanceWeight) SetWeightedCapacity(v *int) *InstanceWeight {
 func (o AvailabilityZone) MarshalJSON() ([]byte, error) {
 	type noMethod AvailabilityZone
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AvailabilityZone) UnmarshalJSON(b []byte) error {
+	type noMethod AvailabilityZone
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AvailabilityZone) SetName(v *string) *AvailabilityZone {
@@ -1040,7 +1136,12 @@ func (o *AvailabilityZone) SetSubnetId(v *string) *AvailabilityZone {
 func (o Tag) MarshalJSON() ([]byte, error) {
 	type noMethod Tag
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Tag) UnmarshalJSON(b []byte) error {
+	type noMethod Tag
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Tag) SetKey(v *string) *Tag {
@@ -1064,7 +1165,12 @@ func (o *Tag) SetValue(v *string) *Tag {
 func (o InstanceGroups) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceGroups
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceGroups) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceGroups
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceGroups) SetMasterGroup(v *InstanceGroup) *InstanceGroups {
@@ -1095,7 +1201,12 @@ func (o *InstanceGroups) SetTaskGroup(v *InstanceGroup) *InstanceGroups {
 func (o InstanceGroup) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceGroup
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceGroup) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceGroup
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceGroup) SetInstanceTypes(v []string) *InstanceGroup {
@@ -1139,7 +1250,12 @@ func (o *InstanceGroup) SetEBSConfiguration(v *EBSConfiguration) *InstanceGroup
 func (o InstanceGroupCapacity) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceGroupCapacity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceGroupCapacity) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceGroupCapacity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceGroupCapacity) SetTarget(v *int) *InstanceGroupCapacity {
@@ -1169,7 +1285,12 @@ func (o *InstanceGroupCapacity) SetMaximum(v *int) *InstanceGroupCapacity {
 func (o EBSConfiguration) MarshalJSON() ([]byte, error) {
 	type noMethod EBSConfiguration
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *EBSConfiguration) UnmarshalJSON(b []byte) error {
+	type noMethod EBSConfiguration
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *EBSConfiguration) SetOptimized(v *bool) *EBSConfiguration {
@@ -1192,7 +1313,12 @@ func (o *EBSConfiguration) SetBlockDeviceConfigs(v []*BlockDeviceConfig) *EBSCon
 func (o BlockDeviceConfig) MarshalJSON() ([]byte, error) {
 	type noMethod BlockDeviceConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BlockDeviceConfig) UnmarshalJSON(b []byte) error {
+	type noMethod BlockDeviceConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BlockDeviceConfig) SetVolumesPerInstance(v *int) *BlockDeviceConfig {
@@ -1215,7 +1341,12 @@ func (o *BlockDeviceConfig) SetVolumeSpecification(v *VolumeSpecification) *Bloc
 func (o VolumeSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod VolumeSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *VolumeSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod VolumeSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *VolumeSpecification) SetVolumeType(v *string) *VolumeSpecification {
@@ -1246,7 +1377,12 @@ func (o *VolumeSpecification) SetIOPS(v *int) *VolumeSpecification {
 func (o Scaling) MarshalJSON() ([]byte, error) {
 	type noMethod Scaling
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Scaling) UnmarshalJSON(b []byte) error {
+	type noMethod Scaling
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Scaling) SetUp(v []*ScalingPolicy) *Scaling {
@@ -1274,7 +1410,12 @@ func (o *Scaling) SetDown(v []*ScalingPolicy) *Scaling {
 func (o ScalingPolicy) MarshalJSON() ([]byte, error) {
 	type noMethod ScalingPolicy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ScalingPolicy) UnmarshalJSON(b []byte) error {
+	type noMethod ScalingPolicy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ScalingPolicy) SetPolicyName(v *string) *ScalingPolicy {
@@ -1368,7 +1509,12 @@ func (o *ScalingPolicy) SetOperator(v *string) *ScalingPolicy {
 func (o Action) MarshalJSON() ([]byte, error) {
 	type noMethod Action
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Action) UnmarshalJSON(b []byte) error {
+	type noMethod Action
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Action) SetType(v *string) *Action {
@@ -1427,7 +1573,12 @@ func (o *Action) SetMaximum(v *string) *Action {
 func (o Dimension) MarshalJSON() ([]byte, error) {
 	type noMethod Dimension
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Dimension) UnmarshalJSON(b []byte) error {
+	type noMethod Dimension
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Dimension) SetName(v *string) *Dimension {
@@ -1451,7 +1602,12 @@ func (o *Dimension) SetValue(v *string) *Dimension {
 func (o Configurations) MarshalJSON() ([]byte, error) {
 	type noMethod Configurations
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Configurations) UnmarshalJSON(b []byte) error {
+	type noMethod Configurations
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Configurations) SetFile(v *S3File) *Configurations {
@@ -1468,7 +1624,12 @@ func (o *Configurations) SetFile(v *S3File) *Configurations {
 func (o BootstrapActions) MarshalJSON() ([]byte, error) {
 	type noMethod BootstrapActions
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BootstrapActions) UnmarshalJSON(b []byte) error {
+	type noMethod BootstrapActions
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BootstrapActions) SetFile(v *S3File) *BootstrapActions {
@@ -1485,7 +1646,12 @@ func (o *BootstrapActions) SetFile(v *S3File) *BootstrapActions {
 func (o Steps) MarshalJSON() ([]byte, error) {
 	type noMethod Steps
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Steps) UnmarshalJSON(b []byte) error {
+	type noMethod Steps
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Steps) SetFile(v *S3File) *Steps {
@@ -1501,7 +1667,12 @@ func (o *Steps) SetFile(v *S3File) *Steps {
 func (o S3File) MarshalJSON() ([]byte, error) {
 	type noMethod S3File
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *S3File) UnmarshalJSON(b []byte) error {
+	type noMethod S3File
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *S3File) SetBucket(v *string) *S3File {
@@ -1537,6 +1708,10 @@ func (s *ServiceOp) List(ctx context.Context, input *ListScalersInput) (*ListSca
 }
 
 func (s *ServiceOp) Create(ctx context.Context, input *CreateScalerInput) (*CreateScalerOutput, error) {
+	if err := s.Client.ValidateCreate(input.Scaler); err != nil {
+		return nil, err
+	}
+
 	r := client.NewRequest(http.MethodPost, "/aws/emr/mrScaler")
 	r.Obj = input
 
@@ -1616,6 +1791,10 @@ func (s *ServiceOp) ReadScalerCluster(ctx context.Context, input *ScalerClusterS
 }
 
 func (s *ServiceOp) Update(ctx context.Context, input *UpdateScalerInput) (*UpdateScalerOutput, error) {
+	if err := s.Client.Validate(input.Scaler); err != nil {
+		return nil, err
+	}
+
 	path, err := uritemplates.Expand("/aws/emr/mrScaler/{mrScalerId}", uritemplates.Values{
 		"mrScalerId": spotinst.StringValue(input.Scaler.ID),
 	})
@@ -1624,10 +1803,13 @@ func (s *ServiceOp) Update(ctx context.Context, input *UpdateScalerInput) (*Upda
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Scaler.ID = nil
+	in := *input
+	scaler := *input.Scaler
+	scaler.ID = nil
+	in.Scaler = &scaler
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
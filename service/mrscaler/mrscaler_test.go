@@ -0,0 +1,84 @@
+package mrscaler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScalerValidate(t *testing.T) {
+	scaler := &Scaler{
+		Compute: &Compute{
+			InstanceGroups: &InstanceGroups{
+				MasterGroup: &InstanceGroup{InstanceTypes: []string{}},
+				CoreGroup: &InstanceGroup{
+					InstanceTypes: []string{"m4.xlarge"},
+					Capacity:      &InstanceGroupCapacity{Minimum: spotinst.Int(3), Maximum: spotinst.Int(2)},
+				},
+				TaskGroup: &InstanceGroup{Target: spotinst.Int(-1)},
+			},
+		},
+		CoreScaling: &Scaling{
+			Up: []*ScalingPolicy{{PolicyName: spotinst.String("up"), MetricName: spotinst.String("YARNMemoryAvailablePercentage")}},
+		},
+	}
+
+	err := scaler.Validate()
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"compute.instanceGroups.masterGroup.instanceTypes: must not be empty; "+
+		"compute.instanceGroups.coreGroup.capacity.minimum: must not be greater than maximum (3 > 2); "+
+		"compute.instanceGroups.taskGroup.target: must not be negative, got -1; "+
+		"coreScaling.up[0].namespace: must be set")
+
+	// Partial scalers, e.g. updates, are valid.
+	partial := &Scaler{Compute: &Compute{InstanceGroups: &InstanceGroups{TaskGroup: &InstanceGroup{Target: spotinst.Int(2)}}}}
+	assert.NoError(t, partial.Validate())
+	assert.NoError(t, (*Scaler)(nil).Validate())
+
+	// Creates require the mandatory fields.
+	scaler = &Scaler{Name: spotinst.String("foo"), Region: spotinst.String("us-west-2")}
+	assert.NoError(t, scaler.Validate())
+	assert.EqualError(t, scaler.ValidateCreate(), "spotinst: invalid resource: strategy: must be set")
+
+	scaler.SetStrategy(&Strategy{})
+	assert.NoError(t, scaler.ValidateCreate())
+}
+
+func TestScalerValidation(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	var updates int
+	srv.Handle(http.MethodPut, "/aws/emr/mrScaler/{mrScalerId}",
+		func(req *http.Request, body []byte) ([]interface{}, error) {
+			updates++
+			return []interface{}{map[string]interface{}{"id": "simrs-1"}}, nil
+		})
+
+	svc := New(srv.Session(), new(spotinst.Config).WithValidation(true))
+	ctx := context.Background()
+
+	invalid := &Scaler{Compute: &Compute{InstanceGroups: &InstanceGroups{TaskGroup: &InstanceGroup{Target: spotinst.Int(-1)}}}}
+	_, err := svc.Create(ctx, &CreateScalerInput{Scaler: invalid})
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"name: must be set; region: must be set; strategy: must be set; "+
+		"compute.instanceGroups.taskGroup.target: must not be negative, got -1")
+
+	group := new(InstanceGroup)
+	group.SetInstanceTypes(nil)
+	_, err = svc.Update(ctx, &UpdateScalerInput{Scaler: &Scaler{ID: spotinst.String("simrs-1"), Compute: &Compute{InstanceGroups: &InstanceGroups{CoreGroup: group}}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: compute.instanceGroups.coreGroup.instanceTypes: must not be empty")
+	assert.Equal(t, 0, updates)
+
+	// Partial updates are valid.
+	_, err = svc.Update(ctx, &UpdateScalerInput{Scaler: &Scaler{ID: spotinst.String("simrs-1"), Description: spotinst.String("foo")}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updates)
+}
@@ -0,0 +1,82 @@
+package mrscaler
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+)
+
+// Validate returns a validation.Errors listing the invalid fields of the
+// scaler, if any. Fields that are not set are not validated, so that partial
+// scalers, e.g. updates, can be validated as well.
+func (o *Scaler) Validate() error {
+	return o.validate(false)
+}
+
+// ValidateCreate is like Validate, but also requires the fields that must be
+// set to create a scaler.
+func (o *Scaler) ValidateCreate() error {
+	return o.validate(true)
+}
+
+func (o *Scaler) validate(create bool) error {
+	if o == nil {
+		return nil
+	}
+
+	v := new(validation.Validator)
+	if create {
+		v.Required("name", o.Name)
+		v.Required("region", o.Region)
+		v.Present("strategy", o.Strategy != nil)
+	}
+	if o.Compute != nil && o.Compute.InstanceGroups != nil {
+		groups := o.Compute.InstanceGroups
+		groups.MasterGroup.validate(v, "compute.instanceGroups.masterGroup")
+		groups.CoreGroup.validate(v, "compute.instanceGroups.coreGroup")
+		groups.TaskGroup.validate(v, "compute.instanceGroups.taskGroup")
+	}
+	o.Scaling.validate(v, "scaling")
+	o.CoreScaling.validate(v, "coreScaling")
+
+	return v.Err()
+}
+
+func (o *InstanceGroup) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	if (o.InstanceTypes != nil && len(o.InstanceTypes) == 0) || validation.IsNull(o.nullFields, "InstanceTypes") {
+		v.Errorf(validation.Field(path, "instanceTypes"), "must not be empty")
+	}
+	if o.Target != nil && *o.Target < 0 {
+		v.Errorf(validation.Field(path, "target"), "must not be negative, got %d", *o.Target)
+	}
+	if c := o.Capacity; c != nil {
+		v.Capacity(validation.Field(path, "capacity"), c.Minimum, c.Maximum, c.Target)
+	}
+}
+
+func (o *Scaling) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	validateScalingPolicies(v, validation.Field(path, "up"), o.Up)
+	validateScalingPolicies(v, validation.Field(path, "down"), o.Down)
+}
+
+func validateScalingPolicies(v *validation.Validator, path string, policies []*ScalingPolicy) {
+	for i, policy := range policies {
+		policy.validate(v, validation.Index(path, i))
+	}
+}
+
+func (o *ScalingPolicy) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	v.Required(validation.Field(path, "policyName"), o.PolicyName)
+	v.Required(validation.Field(path, "metricName"), o.MetricName)
+	v.Required(validation.Field(path, "namespace"), o.Namespace)
+}
@@ -0,0 +1,1200 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of multai.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of multai.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListLoadBalancersFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListLoadBalancersFunc, if set, is called by ListLoadBalancers.
+	ListLoadBalancersFunc func(context.Context, *multai.ListLoadBalancersInput) (*multai.ListLoadBalancersOutput, error)
+
+	// CreateLoadBalancerFunc, if set, is called by CreateLoadBalancer.
+	CreateLoadBalancerFunc func(context.Context, *multai.CreateLoadBalancerInput) (*multai.CreateLoadBalancerOutput, error)
+
+	// ReadLoadBalancerFunc, if set, is called by ReadLoadBalancer.
+	ReadLoadBalancerFunc func(context.Context, *multai.ReadLoadBalancerInput) (*multai.ReadLoadBalancerOutput, error)
+
+	// UpdateLoadBalancerFunc, if set, is called by UpdateLoadBalancer.
+	UpdateLoadBalancerFunc func(context.Context, *multai.UpdateLoadBalancerInput) (*multai.UpdateLoadBalancerOutput, error)
+
+	// DeleteLoadBalancerFunc, if set, is called by DeleteLoadBalancer.
+	DeleteLoadBalancerFunc func(context.Context, *multai.DeleteLoadBalancerInput) (*multai.DeleteLoadBalancerOutput, error)
+
+	// ListListenersFunc, if set, is called by ListListeners.
+	ListListenersFunc func(context.Context, *multai.ListListenersInput) (*multai.ListListenersOutput, error)
+
+	// CreateListenerFunc, if set, is called by CreateListener.
+	CreateListenerFunc func(context.Context, *multai.CreateListenerInput) (*multai.CreateListenerOutput, error)
+
+	// ReadListenerFunc, if set, is called by ReadListener.
+	ReadListenerFunc func(context.Context, *multai.ReadListenerInput) (*multai.ReadListenerOutput, error)
+
+	// UpdateListenerFunc, if set, is called by UpdateListener.
+	UpdateListenerFunc func(context.Context, *multai.UpdateListenerInput) (*multai.UpdateListenerOutput, error)
+
+	// DeleteListenerFunc, if set, is called by DeleteListener.
+	DeleteListenerFunc func(context.Context, *multai.DeleteListenerInput) (*multai.DeleteListenerOutput, error)
+
+	// ListRoutingRulesFunc, if set, is called by ListRoutingRules.
+	ListRoutingRulesFunc func(context.Context, *multai.ListRoutingRulesInput) (*multai.ListRoutingRulesOutput, error)
+
+	// CreateRoutingRuleFunc, if set, is called by CreateRoutingRule.
+	CreateRoutingRuleFunc func(context.Context, *multai.CreateRoutingRuleInput) (*multai.CreateRoutingRuleOutput, error)
+
+	// ReadRoutingRuleFunc, if set, is called by ReadRoutingRule.
+	ReadRoutingRuleFunc func(context.Context, *multai.ReadRoutingRuleInput) (*multai.ReadRoutingRuleOutput, error)
+
+	// UpdateRoutingRuleFunc, if set, is called by UpdateRoutingRule.
+	UpdateRoutingRuleFunc func(context.Context, *multai.UpdateRoutingRuleInput) (*multai.UpdateRoutingRuleOutput, error)
+
+	// DeleteRoutingRuleFunc, if set, is called by DeleteRoutingRule.
+	DeleteRoutingRuleFunc func(context.Context, *multai.DeleteRoutingRuleInput) (*multai.DeleteRoutingRuleOutput, error)
+
+	// ListMiddlewaresFunc, if set, is called by ListMiddlewares.
+	ListMiddlewaresFunc func(context.Context, *multai.ListMiddlewaresInput) (*multai.ListMiddlewaresOutput, error)
+
+	// CreateMiddlewareFunc, if set, is called by CreateMiddleware.
+	CreateMiddlewareFunc func(context.Context, *multai.CreateMiddlewareInput) (*multai.CreateMiddlewareOutput, error)
+
+	// ReadMiddlewareFunc, if set, is called by ReadMiddleware.
+	ReadMiddlewareFunc func(context.Context, *multai.ReadMiddlewareInput) (*multai.ReadMiddlewareOutput, error)
+
+	// UpdateMiddlewareFunc, if set, is called by UpdateMiddleware.
+	UpdateMiddlewareFunc func(context.Context, *multai.UpdateMiddlewareInput) (*multai.UpdateMiddlewareOutput, error)
+
+	// DeleteMiddlewareFunc, if set, is called by DeleteMiddleware.
+	DeleteMiddlewareFunc func(context.Context, *multai.DeleteMiddlewareInput) (*multai.DeleteMiddlewareOutput, error)
+
+	// ListTargetSetsFunc, if set, is called by ListTargetSets.
+	ListTargetSetsFunc func(context.Context, *multai.ListTargetSetsInput) (*multai.ListTargetSetsOutput, error)
+
+	// CreateTargetSetFunc, if set, is called by CreateTargetSet.
+	CreateTargetSetFunc func(context.Context, *multai.CreateTargetSetInput) (*multai.CreateTargetSetOutput, error)
+
+	// ReadTargetSetFunc, if set, is called by ReadTargetSet.
+	ReadTargetSetFunc func(context.Context, *multai.ReadTargetSetInput) (*multai.ReadTargetSetOutput, error)
+
+	// UpdateTargetSetFunc, if set, is called by UpdateTargetSet.
+	UpdateTargetSetFunc func(context.Context, *multai.UpdateTargetSetInput) (*multai.UpdateTargetSetOutput, error)
+
+	// DeleteTargetSetFunc, if set, is called by DeleteTargetSet.
+	DeleteTargetSetFunc func(context.Context, *multai.DeleteTargetSetInput) (*multai.DeleteTargetSetOutput, error)
+
+	// ListTargetsFunc, if set, is called by ListTargets.
+	ListTargetsFunc func(context.Context, *multai.ListTargetsInput) (*multai.ListTargetsOutput, error)
+
+	// CreateTargetFunc, if set, is called by CreateTarget.
+	CreateTargetFunc func(context.Context, *multai.CreateTargetInput) (*multai.CreateTargetOutput, error)
+
+	// ReadTargetFunc, if set, is called by ReadTarget.
+	ReadTargetFunc func(context.Context, *multai.ReadTargetInput) (*multai.ReadTargetOutput, error)
+
+	// UpdateTargetFunc, if set, is called by UpdateTarget.
+	UpdateTargetFunc func(context.Context, *multai.UpdateTargetInput) (*multai.UpdateTargetOutput, error)
+
+	// DeleteTargetFunc, if set, is called by DeleteTarget.
+	DeleteTargetFunc func(context.Context, *multai.DeleteTargetInput) (*multai.DeleteTargetOutput, error)
+
+	// ListDeploymentsFunc, if set, is called by ListDeployments.
+	ListDeploymentsFunc func(context.Context, *multai.ListDeploymentsInput) (*multai.ListDeploymentsOutput, error)
+
+	// CreateDeploymentFunc, if set, is called by CreateDeployment.
+	CreateDeploymentFunc func(context.Context, *multai.CreateDeploymentInput) (*multai.CreateDeploymentOutput, error)
+
+	// ReadDeploymentFunc, if set, is called by ReadDeployment.
+	ReadDeploymentFunc func(context.Context, *multai.ReadDeploymentInput) (*multai.ReadDeploymentOutput, error)
+
+	// UpdateDeploymentFunc, if set, is called by UpdateDeployment.
+	UpdateDeploymentFunc func(context.Context, *multai.UpdateDeploymentInput) (*multai.UpdateDeploymentOutput, error)
+
+	// DeleteDeploymentFunc, if set, is called by DeleteDeployment.
+	DeleteDeploymentFunc func(context.Context, *multai.DeleteDeploymentInput) (*multai.DeleteDeploymentOutput, error)
+
+	// ListCertificatesFunc, if set, is called by ListCertificates.
+	ListCertificatesFunc func(context.Context, *multai.ListCertificatesInput) (*multai.ListCertificatesOutput, error)
+
+	// CreateCertificateFunc, if set, is called by CreateCertificate.
+	CreateCertificateFunc func(context.Context, *multai.CreateCertificateInput) (*multai.CreateCertificateOutput, error)
+
+	// ReadCertificateFunc, if set, is called by ReadCertificate.
+	ReadCertificateFunc func(context.Context, *multai.ReadCertificateInput) (*multai.ReadCertificateOutput, error)
+
+	// UpdateCertificateFunc, if set, is called by UpdateCertificate.
+	UpdateCertificateFunc func(context.Context, *multai.UpdateCertificateInput) (*multai.UpdateCertificateOutput, error)
+
+	// DeleteCertificateFunc, if set, is called by DeleteCertificate.
+	DeleteCertificateFunc func(context.Context, *multai.DeleteCertificateInput) (*multai.DeleteCertificateOutput, error)
+
+	// ListRuntimesFunc, if set, is called by ListRuntimes.
+	ListRuntimesFunc func(context.Context, *multai.ListRuntimesInput) (*multai.ListRuntimesOutput, error)
+
+	// ReadRuntimeFunc, if set, is called by ReadRuntime.
+	ReadRuntimeFunc func(context.Context, *multai.ReadRuntimeInput) (*multai.ReadRuntimeOutput, error)
+}
+
+var _ multai.Service = (*Service)(nil)
+
+// ListLoadBalancers records the call and returns the configured results.
+func (m *Service) ListLoadBalancers(ctx context.Context, input *multai.ListLoadBalancersInput) (*multai.ListLoadBalancersOutput, error) {
+	m.Record("ListLoadBalancers", ctx, input)
+	if m.ListLoadBalancersFunc != nil {
+		return m.ListLoadBalancersFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListLoadBalancersOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListLoadBalancers", 2); ok {
+		if r0, ok = rs[0].(*multai.ListLoadBalancersOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListLoadBalancers returns *multai.ListLoadBalancersOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListLoadBalancers returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListLoadBalancersOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateLoadBalancer records the call and returns the configured results.
+func (m *Service) CreateLoadBalancer(ctx context.Context, input *multai.CreateLoadBalancerInput) (*multai.CreateLoadBalancerOutput, error) {
+	m.Record("CreateLoadBalancer", ctx, input)
+	if m.CreateLoadBalancerFunc != nil {
+		return m.CreateLoadBalancerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateLoadBalancerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateLoadBalancer", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateLoadBalancerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateLoadBalancer returns *multai.CreateLoadBalancerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateLoadBalancer returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateLoadBalancerOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadLoadBalancer records the call and returns the configured results.
+func (m *Service) ReadLoadBalancer(ctx context.Context, input *multai.ReadLoadBalancerInput) (*multai.ReadLoadBalancerOutput, error) {
+	m.Record("ReadLoadBalancer", ctx, input)
+	if m.ReadLoadBalancerFunc != nil {
+		return m.ReadLoadBalancerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadLoadBalancerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadLoadBalancer", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadLoadBalancerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadLoadBalancer returns *multai.ReadLoadBalancerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadLoadBalancer returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadLoadBalancerOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateLoadBalancer records the call and returns the configured results.
+func (m *Service) UpdateLoadBalancer(ctx context.Context, input *multai.UpdateLoadBalancerInput) (*multai.UpdateLoadBalancerOutput, error) {
+	m.Record("UpdateLoadBalancer", ctx, input)
+	if m.UpdateLoadBalancerFunc != nil {
+		return m.UpdateLoadBalancerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateLoadBalancerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateLoadBalancer", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateLoadBalancerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateLoadBalancer returns *multai.UpdateLoadBalancerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateLoadBalancer returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateLoadBalancerOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteLoadBalancer records the call and returns the configured results.
+func (m *Service) DeleteLoadBalancer(ctx context.Context, input *multai.DeleteLoadBalancerInput) (*multai.DeleteLoadBalancerOutput, error) {
+	m.Record("DeleteLoadBalancer", ctx, input)
+	if m.DeleteLoadBalancerFunc != nil {
+		return m.DeleteLoadBalancerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteLoadBalancerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteLoadBalancer", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteLoadBalancerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteLoadBalancer returns *multai.DeleteLoadBalancerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteLoadBalancer returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteLoadBalancerOutput)
+	}
+
+	return r0, r1
+}
+
+// ListListeners records the call and returns the configured results.
+func (m *Service) ListListeners(ctx context.Context, input *multai.ListListenersInput) (*multai.ListListenersOutput, error) {
+	m.Record("ListListeners", ctx, input)
+	if m.ListListenersFunc != nil {
+		return m.ListListenersFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListListenersOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListListeners", 2); ok {
+		if r0, ok = rs[0].(*multai.ListListenersOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListListeners returns *multai.ListListenersOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListListeners returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListListenersOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateListener records the call and returns the configured results.
+func (m *Service) CreateListener(ctx context.Context, input *multai.CreateListenerInput) (*multai.CreateListenerOutput, error) {
+	m.Record("CreateListener", ctx, input)
+	if m.CreateListenerFunc != nil {
+		return m.CreateListenerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateListenerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateListener", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateListenerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateListener returns *multai.CreateListenerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateListener returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateListenerOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadListener records the call and returns the configured results.
+func (m *Service) ReadListener(ctx context.Context, input *multai.ReadListenerInput) (*multai.ReadListenerOutput, error) {
+	m.Record("ReadListener", ctx, input)
+	if m.ReadListenerFunc != nil {
+		return m.ReadListenerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadListenerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadListener", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadListenerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadListener returns *multai.ReadListenerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadListener returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadListenerOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateListener records the call and returns the configured results.
+func (m *Service) UpdateListener(ctx context.Context, input *multai.UpdateListenerInput) (*multai.UpdateListenerOutput, error) {
+	m.Record("UpdateListener", ctx, input)
+	if m.UpdateListenerFunc != nil {
+		return m.UpdateListenerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateListenerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateListener", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateListenerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateListener returns *multai.UpdateListenerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateListener returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateListenerOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteListener records the call and returns the configured results.
+func (m *Service) DeleteListener(ctx context.Context, input *multai.DeleteListenerInput) (*multai.DeleteListenerOutput, error) {
+	m.Record("DeleteListener", ctx, input)
+	if m.DeleteListenerFunc != nil {
+		return m.DeleteListenerFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteListenerOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteListener", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteListenerOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteListener returns *multai.DeleteListenerOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteListener returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteListenerOutput)
+	}
+
+	return r0, r1
+}
+
+// ListRoutingRules records the call and returns the configured results.
+func (m *Service) ListRoutingRules(ctx context.Context, input *multai.ListRoutingRulesInput) (*multai.ListRoutingRulesOutput, error) {
+	m.Record("ListRoutingRules", ctx, input)
+	if m.ListRoutingRulesFunc != nil {
+		return m.ListRoutingRulesFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListRoutingRulesOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListRoutingRules", 2); ok {
+		if r0, ok = rs[0].(*multai.ListRoutingRulesOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListRoutingRules returns *multai.ListRoutingRulesOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListRoutingRules returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListRoutingRulesOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateRoutingRule records the call and returns the configured results.
+func (m *Service) CreateRoutingRule(ctx context.Context, input *multai.CreateRoutingRuleInput) (*multai.CreateRoutingRuleOutput, error) {
+	m.Record("CreateRoutingRule", ctx, input)
+	if m.CreateRoutingRuleFunc != nil {
+		return m.CreateRoutingRuleFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateRoutingRuleOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateRoutingRule", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateRoutingRuleOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateRoutingRule returns *multai.CreateRoutingRuleOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateRoutingRule returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateRoutingRuleOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadRoutingRule records the call and returns the configured results.
+func (m *Service) ReadRoutingRule(ctx context.Context, input *multai.ReadRoutingRuleInput) (*multai.ReadRoutingRuleOutput, error) {
+	m.Record("ReadRoutingRule", ctx, input)
+	if m.ReadRoutingRuleFunc != nil {
+		return m.ReadRoutingRuleFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadRoutingRuleOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadRoutingRule", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadRoutingRuleOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadRoutingRule returns *multai.ReadRoutingRuleOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadRoutingRule returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadRoutingRuleOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateRoutingRule records the call and returns the configured results.
+func (m *Service) UpdateRoutingRule(ctx context.Context, input *multai.UpdateRoutingRuleInput) (*multai.UpdateRoutingRuleOutput, error) {
+	m.Record("UpdateRoutingRule", ctx, input)
+	if m.UpdateRoutingRuleFunc != nil {
+		return m.UpdateRoutingRuleFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateRoutingRuleOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateRoutingRule", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateRoutingRuleOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateRoutingRule returns *multai.UpdateRoutingRuleOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateRoutingRule returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateRoutingRuleOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteRoutingRule records the call and returns the configured results.
+func (m *Service) DeleteRoutingRule(ctx context.Context, input *multai.DeleteRoutingRuleInput) (*multai.DeleteRoutingRuleOutput, error) {
+	m.Record("DeleteRoutingRule", ctx, input)
+	if m.DeleteRoutingRuleFunc != nil {
+		return m.DeleteRoutingRuleFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteRoutingRuleOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteRoutingRule", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteRoutingRuleOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteRoutingRule returns *multai.DeleteRoutingRuleOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteRoutingRule returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteRoutingRuleOutput)
+	}
+
+	return r0, r1
+}
+
+// ListMiddlewares records the call and returns the configured results.
+func (m *Service) ListMiddlewares(ctx context.Context, input *multai.ListMiddlewaresInput) (*multai.ListMiddlewaresOutput, error) {
+	m.Record("ListMiddlewares", ctx, input)
+	if m.ListMiddlewaresFunc != nil {
+		return m.ListMiddlewaresFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListMiddlewaresOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListMiddlewares", 2); ok {
+		if r0, ok = rs[0].(*multai.ListMiddlewaresOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListMiddlewares returns *multai.ListMiddlewaresOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListMiddlewares returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListMiddlewaresOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateMiddleware records the call and returns the configured results.
+func (m *Service) CreateMiddleware(ctx context.Context, input *multai.CreateMiddlewareInput) (*multai.CreateMiddlewareOutput, error) {
+	m.Record("CreateMiddleware", ctx, input)
+	if m.CreateMiddlewareFunc != nil {
+		return m.CreateMiddlewareFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateMiddlewareOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateMiddleware", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateMiddlewareOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateMiddleware returns *multai.CreateMiddlewareOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateMiddleware returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateMiddlewareOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadMiddleware records the call and returns the configured results.
+func (m *Service) ReadMiddleware(ctx context.Context, input *multai.ReadMiddlewareInput) (*multai.ReadMiddlewareOutput, error) {
+	m.Record("ReadMiddleware", ctx, input)
+	if m.ReadMiddlewareFunc != nil {
+		return m.ReadMiddlewareFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadMiddlewareOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadMiddleware", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadMiddlewareOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadMiddleware returns *multai.ReadMiddlewareOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadMiddleware returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadMiddlewareOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateMiddleware records the call and returns the configured results.
+func (m *Service) UpdateMiddleware(ctx context.Context, input *multai.UpdateMiddlewareInput) (*multai.UpdateMiddlewareOutput, error) {
+	m.Record("UpdateMiddleware", ctx, input)
+	if m.UpdateMiddlewareFunc != nil {
+		return m.UpdateMiddlewareFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateMiddlewareOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateMiddleware", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateMiddlewareOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateMiddleware returns *multai.UpdateMiddlewareOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateMiddleware returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateMiddlewareOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteMiddleware records the call and returns the configured results.
+func (m *Service) DeleteMiddleware(ctx context.Context, input *multai.DeleteMiddlewareInput) (*multai.DeleteMiddlewareOutput, error) {
+	m.Record("DeleteMiddleware", ctx, input)
+	if m.DeleteMiddlewareFunc != nil {
+		return m.DeleteMiddlewareFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteMiddlewareOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteMiddleware", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteMiddlewareOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteMiddleware returns *multai.DeleteMiddlewareOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteMiddleware returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteMiddlewareOutput)
+	}
+
+	return r0, r1
+}
+
+// ListTargetSets records the call and returns the configured results.
+func (m *Service) ListTargetSets(ctx context.Context, input *multai.ListTargetSetsInput) (*multai.ListTargetSetsOutput, error) {
+	m.Record("ListTargetSets", ctx, input)
+	if m.ListTargetSetsFunc != nil {
+		return m.ListTargetSetsFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListTargetSetsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListTargetSets", 2); ok {
+		if r0, ok = rs[0].(*multai.ListTargetSetsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListTargetSets returns *multai.ListTargetSetsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListTargetSets returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListTargetSetsOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateTargetSet records the call and returns the configured results.
+func (m *Service) CreateTargetSet(ctx context.Context, input *multai.CreateTargetSetInput) (*multai.CreateTargetSetOutput, error) {
+	m.Record("CreateTargetSet", ctx, input)
+	if m.CreateTargetSetFunc != nil {
+		return m.CreateTargetSetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateTargetSetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateTargetSet", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateTargetSetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateTargetSet returns *multai.CreateTargetSetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateTargetSet returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateTargetSetOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadTargetSet records the call and returns the configured results.
+func (m *Service) ReadTargetSet(ctx context.Context, input *multai.ReadTargetSetInput) (*multai.ReadTargetSetOutput, error) {
+	m.Record("ReadTargetSet", ctx, input)
+	if m.ReadTargetSetFunc != nil {
+		return m.ReadTargetSetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadTargetSetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadTargetSet", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadTargetSetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadTargetSet returns *multai.ReadTargetSetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadTargetSet returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadTargetSetOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateTargetSet records the call and returns the configured results.
+func (m *Service) UpdateTargetSet(ctx context.Context, input *multai.UpdateTargetSetInput) (*multai.UpdateTargetSetOutput, error) {
+	m.Record("UpdateTargetSet", ctx, input)
+	if m.UpdateTargetSetFunc != nil {
+		return m.UpdateTargetSetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateTargetSetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateTargetSet", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateTargetSetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateTargetSet returns *multai.UpdateTargetSetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateTargetSet returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateTargetSetOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteTargetSet records the call and returns the configured results.
+func (m *Service) DeleteTargetSet(ctx context.Context, input *multai.DeleteTargetSetInput) (*multai.DeleteTargetSetOutput, error) {
+	m.Record("DeleteTargetSet", ctx, input)
+	if m.DeleteTargetSetFunc != nil {
+		return m.DeleteTargetSetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteTargetSetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteTargetSet", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteTargetSetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteTargetSet returns *multai.DeleteTargetSetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteTargetSet returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteTargetSetOutput)
+	}
+
+	return r0, r1
+}
+
+// ListTargets records the call and returns the configured results.
+func (m *Service) ListTargets(ctx context.Context, input *multai.ListTargetsInput) (*multai.ListTargetsOutput, error) {
+	m.Record("ListTargets", ctx, input)
+	if m.ListTargetsFunc != nil {
+		return m.ListTargetsFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListTargetsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListTargets", 2); ok {
+		if r0, ok = rs[0].(*multai.ListTargetsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListTargets returns *multai.ListTargetsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListTargets returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListTargetsOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateTarget records the call and returns the configured results.
+func (m *Service) CreateTarget(ctx context.Context, input *multai.CreateTargetInput) (*multai.CreateTargetOutput, error) {
+	m.Record("CreateTarget", ctx, input)
+	if m.CreateTargetFunc != nil {
+		return m.CreateTargetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateTargetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateTarget", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateTargetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateTarget returns *multai.CreateTargetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateTarget returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateTargetOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadTarget records the call and returns the configured results.
+func (m *Service) ReadTarget(ctx context.Context, input *multai.ReadTargetInput) (*multai.ReadTargetOutput, error) {
+	m.Record("ReadTarget", ctx, input)
+	if m.ReadTargetFunc != nil {
+		return m.ReadTargetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadTargetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadTarget", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadTargetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadTarget returns *multai.ReadTargetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadTarget returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadTargetOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateTarget records the call and returns the configured results.
+func (m *Service) UpdateTarget(ctx context.Context, input *multai.UpdateTargetInput) (*multai.UpdateTargetOutput, error) {
+	m.Record("UpdateTarget", ctx, input)
+	if m.UpdateTargetFunc != nil {
+		return m.UpdateTargetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateTargetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateTarget", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateTargetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateTarget returns *multai.UpdateTargetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateTarget returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateTargetOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteTarget records the call and returns the configured results.
+func (m *Service) DeleteTarget(ctx context.Context, input *multai.DeleteTargetInput) (*multai.DeleteTargetOutput, error) {
+	m.Record("DeleteTarget", ctx, input)
+	if m.DeleteTargetFunc != nil {
+		return m.DeleteTargetFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteTargetOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteTarget", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteTargetOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteTarget returns *multai.DeleteTargetOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteTarget returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteTargetOutput)
+	}
+
+	return r0, r1
+}
+
+// ListDeployments records the call and returns the configured results.
+func (m *Service) ListDeployments(ctx context.Context, input *multai.ListDeploymentsInput) (*multai.ListDeploymentsOutput, error) {
+	m.Record("ListDeployments", ctx, input)
+	if m.ListDeploymentsFunc != nil {
+		return m.ListDeploymentsFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListDeploymentsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListDeployments", 2); ok {
+		if r0, ok = rs[0].(*multai.ListDeploymentsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListDeployments returns *multai.ListDeploymentsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListDeployments returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListDeploymentsOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateDeployment records the call and returns the configured results.
+func (m *Service) CreateDeployment(ctx context.Context, input *multai.CreateDeploymentInput) (*multai.CreateDeploymentOutput, error) {
+	m.Record("CreateDeployment", ctx, input)
+	if m.CreateDeploymentFunc != nil {
+		return m.CreateDeploymentFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateDeploymentOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateDeployment", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateDeploymentOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateDeployment returns *multai.CreateDeploymentOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateDeployment returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateDeploymentOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadDeployment records the call and returns the configured results.
+func (m *Service) ReadDeployment(ctx context.Context, input *multai.ReadDeploymentInput) (*multai.ReadDeploymentOutput, error) {
+	m.Record("ReadDeployment", ctx, input)
+	if m.ReadDeploymentFunc != nil {
+		return m.ReadDeploymentFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadDeploymentOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadDeployment", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadDeploymentOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadDeployment returns *multai.ReadDeploymentOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadDeployment returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadDeploymentOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateDeployment records the call and returns the configured results.
+func (m *Service) UpdateDeployment(ctx context.Context, input *multai.UpdateDeploymentInput) (*multai.UpdateDeploymentOutput, error) {
+	m.Record("UpdateDeployment", ctx, input)
+	if m.UpdateDeploymentFunc != nil {
+		return m.UpdateDeploymentFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateDeploymentOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateDeployment", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateDeploymentOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateDeployment returns *multai.UpdateDeploymentOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateDeployment returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateDeploymentOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteDeployment records the call and returns the configured results.
+func (m *Service) DeleteDeployment(ctx context.Context, input *multai.DeleteDeploymentInput) (*multai.DeleteDeploymentOutput, error) {
+	m.Record("DeleteDeployment", ctx, input)
+	if m.DeleteDeploymentFunc != nil {
+		return m.DeleteDeploymentFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteDeploymentOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteDeployment", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteDeploymentOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteDeployment returns *multai.DeleteDeploymentOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteDeployment returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteDeploymentOutput)
+	}
+
+	return r0, r1
+}
+
+// ListCertificates records the call and returns the configured results.
+func (m *Service) ListCertificates(ctx context.Context, input *multai.ListCertificatesInput) (*multai.ListCertificatesOutput, error) {
+	m.Record("ListCertificates", ctx, input)
+	if m.ListCertificatesFunc != nil {
+		return m.ListCertificatesFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListCertificatesOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListCertificates", 2); ok {
+		if r0, ok = rs[0].(*multai.ListCertificatesOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListCertificates returns *multai.ListCertificatesOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListCertificates returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListCertificatesOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateCertificate records the call and returns the configured results.
+func (m *Service) CreateCertificate(ctx context.Context, input *multai.CreateCertificateInput) (*multai.CreateCertificateOutput, error) {
+	m.Record("CreateCertificate", ctx, input)
+	if m.CreateCertificateFunc != nil {
+		return m.CreateCertificateFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.CreateCertificateOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateCertificate", 2); ok {
+		if r0, ok = rs[0].(*multai.CreateCertificateOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateCertificate returns *multai.CreateCertificateOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateCertificate returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.CreateCertificateOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadCertificate records the call and returns the configured results.
+func (m *Service) ReadCertificate(ctx context.Context, input *multai.ReadCertificateInput) (*multai.ReadCertificateOutput, error) {
+	m.Record("ReadCertificate", ctx, input)
+	if m.ReadCertificateFunc != nil {
+		return m.ReadCertificateFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadCertificateOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadCertificate", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadCertificateOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadCertificate returns *multai.ReadCertificateOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadCertificate returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadCertificateOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateCertificate records the call and returns the configured results.
+func (m *Service) UpdateCertificate(ctx context.Context, input *multai.UpdateCertificateInput) (*multai.UpdateCertificateOutput, error) {
+	m.Record("UpdateCertificate", ctx, input)
+	if m.UpdateCertificateFunc != nil {
+		return m.UpdateCertificateFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.UpdateCertificateOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateCertificate", 2); ok {
+		if r0, ok = rs[0].(*multai.UpdateCertificateOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateCertificate returns *multai.UpdateCertificateOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateCertificate returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.UpdateCertificateOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteCertificate records the call and returns the configured results.
+func (m *Service) DeleteCertificate(ctx context.Context, input *multai.DeleteCertificateInput) (*multai.DeleteCertificateOutput, error) {
+	m.Record("DeleteCertificate", ctx, input)
+	if m.DeleteCertificateFunc != nil {
+		return m.DeleteCertificateFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.DeleteCertificateOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteCertificate", 2); ok {
+		if r0, ok = rs[0].(*multai.DeleteCertificateOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteCertificate returns *multai.DeleteCertificateOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteCertificate returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.DeleteCertificateOutput)
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes records the call and returns the configured results.
+func (m *Service) ListRuntimes(ctx context.Context, input *multai.ListRuntimesInput) (*multai.ListRuntimesOutput, error) {
+	m.Record("ListRuntimes", ctx, input)
+	if m.ListRuntimesFunc != nil {
+		return m.ListRuntimesFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ListRuntimesOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListRuntimes", 2); ok {
+		if r0, ok = rs[0].(*multai.ListRuntimesOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListRuntimes returns *multai.ListRuntimesOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListRuntimes returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ListRuntimesOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadRuntime records the call and returns the configured results.
+func (m *Service) ReadRuntime(ctx context.Context, input *multai.ReadRuntimeInput) (*multai.ReadRuntimeOutput, error) {
+	m.Record("ReadRuntime", ctx, input)
+	if m.ReadRuntimeFunc != nil {
+		return m.ReadRuntimeFunc(ctx, input)
+	}
+
+	var (
+		r0 *multai.ReadRuntimeOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadRuntime", 2); ok {
+		if r0, ok = rs[0].(*multai.ReadRuntimeOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadRuntime returns *multai.ReadRuntimeOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadRuntime returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(multai.ReadRuntimeOutput)
+	}
+
+	return r0, r1
+}
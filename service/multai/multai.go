@@ -168,6 +168,7 @@ type LoadBalancer struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Timeouts struct {
@@ -330,10 +331,13 @@ func (s *ServiceOp) UpdateLoadBalancer(ctx context.Context, input *UpdateLoadBal
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Balancer.ID = nil
+	in := *input
+	balancer := *input.Balancer
+	balancer.ID = nil
+	in.Balancer = &balancer
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -367,7 +371,12 @@ func (s *ServiceOp) DeleteLoadBalancer(ctx context.Context, input *DeleteLoadBal
 func (o LoadBalancer) MarshalJSON() ([]byte, error) {
 	type noMethod LoadBalancer
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LoadBalancer) UnmarshalJSON(b []byte) error {
+	type noMethod LoadBalancer
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LoadBalancer) SetId(v *string) *LoadBalancer {
@@ -440,6 +449,7 @@ type Listener struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListListenersInput struct {
@@ -594,10 +604,13 @@ func (s *ServiceOp) UpdateListener(ctx context.Context, input *UpdateListenerInp
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Listener.ID = nil
+	in := *input
+	listener := *input.Listener
+	listener.ID = nil
+	in.Listener = &listener
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -631,7 +644,12 @@ func (s *ServiceOp) DeleteListener(ctx context.Context, input *DeleteListenerInp
 func (o Listener) MarshalJSON() ([]byte, error) {
 	type noMethod Listener
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Listener) UnmarshalJSON(b []byte) error {
+	type noMethod Listener
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Listener) SetId(v *string) *Listener {
@@ -683,7 +701,12 @@ func (o *Listener) SetTags(v []*Tag) *Listener {
 func (o TLSConfig) MarshalJSON() ([]byte, error) {
 	type noMethod TLSConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *TLSConfig) UnmarshalJSON(b []byte) error {
+	type noMethod TLSConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *TLSConfig) SetCertificateIDs(v []string) *TLSConfig {
@@ -752,6 +775,7 @@ type RoutingRule struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListRoutingRulesInput struct {
@@ -906,10 +930,13 @@ func (s *ServiceOp) UpdateRoutingRule(ctx context.Context, input *UpdateRoutingR
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.RoutingRule.ID = nil
+	in := *input
+	routingRule := *input.RoutingRule
+	routingRule.ID = nil
+	in.RoutingRule = &routingRule
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -943,7 +970,12 @@ func (s *ServiceOp) DeleteRoutingRule(ctx context.Context, input *DeleteRoutingR
 func (o RoutingRule) MarshalJSON() ([]byte, error) {
 	type noMethod RoutingRule
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *RoutingRule) UnmarshalJSON(b []byte) error {
+	type noMethod RoutingRule
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *RoutingRule) SetId(v *string) *RoutingRule {
@@ -1023,6 +1055,7 @@ type Middleware struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListMiddlewaresInput struct {
@@ -1177,10 +1210,13 @@ func (s *ServiceOp) UpdateMiddleware(ctx context.Context, input *UpdateMiddlewar
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Middleware.ID = nil
+	in := *input
+	middleware := *input.Middleware
+	middleware.ID = nil
+	in.Middleware = &middleware
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1214,7 +1250,12 @@ func (s *ServiceOp) DeleteMiddleware(ctx context.Context, input *DeleteMiddlewar
 func (o Middleware) MarshalJSON() ([]byte, error) {
 	type noMethod Middleware
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Middleware) UnmarshalJSON(b []byte) error {
+	type noMethod Middleware
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Middleware) SetId(v *string) *Middleware {
@@ -1276,6 +1317,7 @@ type TargetSet struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type TargetSetHealthCheck struct {
@@ -1289,6 +1331,7 @@ type TargetSetHealthCheck struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListTargetSetsInput struct {
@@ -1443,10 +1486,13 @@ func (s *ServiceOp) UpdateTargetSet(ctx context.Context, input *UpdateTargetSetI
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.TargetSet.ID = nil
+	in := *input
+	targetSet := *input.TargetSet
+	targetSet.ID = nil
+	in.TargetSet = &targetSet
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1480,7 +1526,12 @@ func (s *ServiceOp) DeleteTargetSet(ctx context.Context, input *DeleteTargetSetI
 func (o TargetSet) MarshalJSON() ([]byte, error) {
 	type noMethod TargetSet
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *TargetSet) UnmarshalJSON(b []byte) error {
+	type noMethod TargetSet
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *TargetSet) SetId(v *string) *TargetSet {
@@ -1553,7 +1604,12 @@ func (o *TargetSet) SetTags(v []*Tag) *TargetSet {
 func (o TargetSetHealthCheck) MarshalJSON() ([]byte, error) {
 	type noMethod TargetSetHealthCheck
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *TargetSetHealthCheck) UnmarshalJSON(b []byte) error {
+	type noMethod TargetSetHealthCheck
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *TargetSetHealthCheck) SetPath(v *string) *TargetSetHealthCheck {
@@ -1622,6 +1678,7 @@ type Target struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Status struct {
@@ -1793,10 +1850,13 @@ func (s *ServiceOp) UpdateTarget(ctx context.Context, input *UpdateTargetInput)
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Target.ID = nil
+	in := *input
+	target := *input.Target
+	target.ID = nil
+	in.Target = &target
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -1830,7 +1890,12 @@ func (s *ServiceOp) DeleteTarget(ctx context.Context, input *DeleteTargetInput)
 func (o Target) MarshalJSON() ([]byte, error) {
 	type noMethod Target
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Target) UnmarshalJSON(b []byte) error {
+	type noMethod Target
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Target) SetId(v *string) *Target {
@@ -1912,6 +1977,7 @@ type Runtime struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListRuntimesInput struct {
@@ -2019,7 +2085,12 @@ func (s *ServiceOp) ReadRuntime(ctx context.Context, input *ReadRuntimeInput) (*
 func (o Runtime) MarshalJSON() ([]byte, error) {
 	type noMethod Runtime
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Runtime) UnmarshalJSON(b []byte) error {
+	type noMethod Runtime
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Runtime) SetId(v *string) *Runtime {
@@ -2061,6 +2132,7 @@ type Deployment struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListDeploymentsInput struct{}
@@ -2208,10 +2280,13 @@ func (s *ServiceOp) UpdateDeployment(ctx context.Context, input *UpdateDeploymen
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Deployment.ID = nil
+	in := *input
+	deployment := *input.Deployment
+	deployment.ID = nil
+	in.Deployment = &deployment
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -2245,7 +2320,12 @@ func (s *ServiceOp) DeleteDeployment(ctx context.Context, input *DeleteDeploymen
 func (o Deployment) MarshalJSON() ([]byte, error) {
 	type noMethod Deployment
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Deployment) UnmarshalJSON(b []byte) error {
+	type noMethod Deployment
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Deployment) SetId(v *string) *Deployment {
@@ -2429,10 +2509,13 @@ func (s *ServiceOp) UpdateCertificate(ctx context.Context, input *UpdateCertific
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Certificate.ID = nil
+	in := *input
+	certificate := *input.Certificate
+	certificate.ID = nil
+	in.Certificate = &certificate
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -0,0 +1,16 @@
+package multai
+
+import "github.com/spotinst/spotinst-sdk-go/spotinst/spec"
+
+// Register the kinds of the resources of the service, to decode them from spec
+// files.
+func init() {
+	spec.Register("MultaiBalancer", (*LoadBalancer)(nil))
+	spec.Register("MultaiListener", (*Listener)(nil))
+	spec.Register("MultaiRoutingRule", (*RoutingRule)(nil))
+	spec.Register("MultaiMiddleware", (*Middleware)(nil))
+	spec.Register("MultaiTargetSet", (*TargetSet)(nil))
+	spec.Register("MultaiTarget", (*Target)(nil))
+	spec.Register("MultaiDeployment", (*Deployment)(nil))
+	spec.Register("MultaiCertificate", (*Certificate)(nil))
+}
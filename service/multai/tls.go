@@ -3,6 +3,8 @@ package multai
 import (
 	"crypto/tls"
 	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/jsonutil"
 )
 
 var TLSVersionName = map[uint16]string{
@@ -107,6 +109,7 @@ type TLSConfig struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 func ParseTLSCipherSuite(suite string) (uint16, error) {
@@ -1,5 +1,7 @@
 package ocean
 
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen
+
 import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/diff"
+)
+
+// launchSpecPaths are the paths of the fields whose changes only apply to the
+// instances of a cluster once it is rolled.
+var launchSpecPaths = []string{"compute.launchSpecification"}
+
+type ApplyClusterInput struct {
+	// The desired state of the cluster. The existing cluster is looked up by
+	// its ID if set, or else by its name.
+	Cluster *Cluster `json:"cluster,omitempty"`
+
+	// If true, the changes are computed but not sent.
+	PlanOnly *bool `json:"planOnly,omitempty"`
+
+	// If set, the cluster is rolled with these settings after an update
+	// changing its launch specification. Its ClusterID is set by Apply.
+	Roll *RollClusterInput `json:"roll,omitempty"`
+}
+
+type ApplyClusterOutput struct {
+	// The cluster as created or updated, or the existing cluster, if any, if
+	// unchanged or in plan-only mode.
+	Cluster *Cluster `json:"cluster,omitempty"`
+
+	// What was done, or would be done in plan-only mode, to the cluster.
+	Action diff.Action `json:"action"`
+
+	// The changes made, or to be made in plan-only mode, to the cluster.
+	Changes diff.Changes `json:"changes,omitempty"`
+
+	// The output of the roll of the cluster, if rolled.
+	Roll *RollClusterOutput `json:"roll,omitempty"`
+}
+
+// Apply reconciles a cluster with its desired state, as diff.Apply does: it
+// looks up the existing cluster by the ID of the desired one if set, or else
+// by its name, creates it if it does not exist, or else updates the fields
+// that the desired state sets or explicitly nulls only. If the roll following an
+// update fails, the output is returned along with the error.
+func Apply(ctx context.Context, svc Service, input *ApplyClusterInput) (*ApplyClusterOutput, error) {
+	output := new(ApplyClusterOutput)
+
+	r := &diff.Resource{
+		Kind: "cluster",
+		Read: func(ctx context.Context, id string) (interface{}, error) {
+			out, err := svc.ReadCluster(ctx, &ReadClusterInput{ClusterID: spotinst.String(id)})
+			if err != nil {
+				return nil, err
+			}
+			return out.Cluster, nil
+		},
+		List: func(ctx context.Context) (interface{}, error) {
+			out, err := svc.ListClusters(ctx, &ListClustersInput{})
+			if err != nil {
+				return nil, err
+			}
+			return out.Clusters, nil
+		},
+		Create: func(ctx context.Context, desired interface{}) (interface{}, error) {
+			// The cluster may have been deleted, so it gets a new ID.
+			cluster := *desired.(*Cluster)
+			cluster.ID = nil
+
+			out, err := svc.CreateCluster(ctx, &CreateClusterInput{Cluster: &cluster})
+			if err != nil {
+				return nil, err
+			}
+			return out.Cluster, nil
+		},
+		Update: func(ctx context.Context, update interface{}) (interface{}, error) {
+			out, err := svc.UpdateCluster(ctx, &UpdateClusterInput{Cluster: update.(*Cluster)})
+			if err != nil {
+				return nil, err
+			}
+			return out.Cluster, nil
+		},
+		RollPaths: launchSpecPaths,
+	}
+
+	if input.Roll != nil {
+		r.Roll = func(ctx context.Context, id string) (err error) {
+			roll := new(Roll)
+			if input.Roll.Roll != nil {
+				*roll = *input.Roll.Roll
+			}
+			roll.ClusterID = spotinst.String(id)
+
+			output.Roll, err = svc.Roll(ctx, &RollClusterInput{Roll: roll})
+			return err
+		}
+	}
+
+	result, err := diff.Apply(ctx, r, input.Cluster, spotinst.BoolValue(input.PlanOnly))
+	if result == nil {
+		return nil, err
+	}
+
+	output.Cluster, _ = result.Resource.(*Cluster)
+	output.Action = result.Action
+	output.Changes = result.Changes
+
+	return output, err
+}
@@ -108,10 +108,12 @@ func (s *ServiceOp) DetachClusterInstances(ctx context.Context, input *DetachClu
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.ClusterID = nil
+	in := *input
+	in.ClusterID = nil
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.NotIdempotent = true
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
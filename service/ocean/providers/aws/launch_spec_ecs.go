@@ -43,6 +43,10 @@ type ECSLaunchSpec struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type ECSAttribute struct {
@@ -51,6 +55,7 @@ type ECSAttribute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSAutoScale struct {
@@ -58,6 +63,7 @@ type ECSAutoScale struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSAutoScaleHeadroom struct {
@@ -67,6 +73,7 @@ type ECSAutoScaleHeadroom struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListECSLaunchSpecsInput struct {
@@ -223,10 +230,13 @@ func (s *ServiceOp) UpdateECSLaunchSpec(ctx context.Context, input *UpdateECSLau
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.LaunchSpec.ID = nil
+	in := *input
+	launchSpec := *input.LaunchSpec
+	launchSpec.ID = nil
+	in.LaunchSpec = &launchSpec
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -273,7 +283,12 @@ func (s *ServiceOp) DeleteECSLaunchSpec(ctx context.Context, input *DeleteECSLau
 func (o ECSLaunchSpec) MarshalJSON() ([]byte, error) {
 	type noMethod ECSLaunchSpec
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSLaunchSpec) UnmarshalJSON(b []byte) error {
+	type noMethod ECSLaunchSpec
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSLaunchSpec) SetId(v *string) *ECSLaunchSpec {
@@ -346,7 +361,12 @@ func (o *ECSLaunchSpec) SetAutoScale(v *ECSAutoScale) *ECSLaunchSpec {
 func (o ECSAttribute) MarshalJSON() ([]byte, error) {
 	type noMethod ECSAttribute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSAttribute) UnmarshalJSON(b []byte) error {
+	type noMethod ECSAttribute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSAttribute) SetKey(v *string) *ECSAttribute {
@@ -370,7 +390,12 @@ func (o *ECSAttribute) SetValue(v *string) *ECSAttribute {
 func (o ECSAutoScale) MarshalJSON() ([]byte, error) {
 	type noMethod ECSAutoScale
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSAutoScale) UnmarshalJSON(b []byte) error {
+	type noMethod ECSAutoScale
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSAutoScale) SetHeadrooms(v []*ECSAutoScaleHeadroom) *ECSAutoScale {
@@ -387,7 +412,12 @@ func (o *ECSAutoScale) SetHeadrooms(v []*ECSAutoScaleHeadroom) *ECSAutoScale {
 func (o ECSAutoScaleHeadroom) MarshalJSON() ([]byte, error) {
 	type noMethod ECSAutoScaleHeadroom
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSAutoScaleHeadroom) UnmarshalJSON(b []byte) error {
+	type noMethod ECSAutoScaleHeadroom
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSAutoScaleHeadroom) SetCPUPerUnit(v *int) *ECSAutoScaleHeadroom {
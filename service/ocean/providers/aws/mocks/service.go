@@ -0,0 +1,752 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of aws.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of aws.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListClustersFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListClustersFunc, if set, is called by ListClusters.
+	ListClustersFunc func(context.Context, *aws.ListClustersInput) (*aws.ListClustersOutput, error)
+
+	// CreateClusterFunc, if set, is called by CreateCluster.
+	CreateClusterFunc func(context.Context, *aws.CreateClusterInput) (*aws.CreateClusterOutput, error)
+
+	// ReadClusterFunc, if set, is called by ReadCluster.
+	ReadClusterFunc func(context.Context, *aws.ReadClusterInput) (*aws.ReadClusterOutput, error)
+
+	// UpdateClusterFunc, if set, is called by UpdateCluster.
+	UpdateClusterFunc func(context.Context, *aws.UpdateClusterInput) (*aws.UpdateClusterOutput, error)
+
+	// DeleteClusterFunc, if set, is called by DeleteCluster.
+	DeleteClusterFunc func(context.Context, *aws.DeleteClusterInput) (*aws.DeleteClusterOutput, error)
+
+	// ListLaunchSpecsFunc, if set, is called by ListLaunchSpecs.
+	ListLaunchSpecsFunc func(context.Context, *aws.ListLaunchSpecsInput) (*aws.ListLaunchSpecsOutput, error)
+
+	// CreateLaunchSpecFunc, if set, is called by CreateLaunchSpec.
+	CreateLaunchSpecFunc func(context.Context, *aws.CreateLaunchSpecInput) (*aws.CreateLaunchSpecOutput, error)
+
+	// ReadLaunchSpecFunc, if set, is called by ReadLaunchSpec.
+	ReadLaunchSpecFunc func(context.Context, *aws.ReadLaunchSpecInput) (*aws.ReadLaunchSpecOutput, error)
+
+	// UpdateLaunchSpecFunc, if set, is called by UpdateLaunchSpec.
+	UpdateLaunchSpecFunc func(context.Context, *aws.UpdateLaunchSpecInput) (*aws.UpdateLaunchSpecOutput, error)
+
+	// DeleteLaunchSpecFunc, if set, is called by DeleteLaunchSpec.
+	DeleteLaunchSpecFunc func(context.Context, *aws.DeleteLaunchSpecInput) (*aws.DeleteLaunchSpecOutput, error)
+
+	// ListClusterInstancesFunc, if set, is called by ListClusterInstances.
+	ListClusterInstancesFunc func(context.Context, *aws.ListClusterInstancesInput) (*aws.ListClusterInstancesOutput, error)
+
+	// DetachClusterInstancesFunc, if set, is called by DetachClusterInstances.
+	DetachClusterInstancesFunc func(context.Context, *aws.DetachClusterInstancesInput) (*aws.DetachClusterInstancesOutput, error)
+
+	// RollFunc, if set, is called by Roll.
+	RollFunc func(context.Context, *aws.RollClusterInput) (*aws.RollClusterOutput, error)
+
+	// ReadRollFunc, if set, is called by ReadRoll.
+	ReadRollFunc func(context.Context, *aws.ReadRollInput) (*aws.ReadRollOutput, error)
+
+	// ListECSClustersFunc, if set, is called by ListECSClusters.
+	ListECSClustersFunc func(context.Context, *aws.ListECSClustersInput) (*aws.ListECSClustersOutput, error)
+
+	// CreateECSClusterFunc, if set, is called by CreateECSCluster.
+	CreateECSClusterFunc func(context.Context, *aws.CreateECSClusterInput) (*aws.CreateECSClusterOutput, error)
+
+	// ReadECSClusterFunc, if set, is called by ReadECSCluster.
+	ReadECSClusterFunc func(context.Context, *aws.ReadECSClusterInput) (*aws.ReadECSClusterOutput, error)
+
+	// UpdateECSClusterFunc, if set, is called by UpdateECSCluster.
+	UpdateECSClusterFunc func(context.Context, *aws.UpdateECSClusterInput) (*aws.UpdateECSClusterOutput, error)
+
+	// DeleteECSClusterFunc, if set, is called by DeleteECSCluster.
+	DeleteECSClusterFunc func(context.Context, *aws.DeleteECSClusterInput) (*aws.DeleteECSClusterOutput, error)
+
+	// ListECSLaunchSpecsFunc, if set, is called by ListECSLaunchSpecs.
+	ListECSLaunchSpecsFunc func(context.Context, *aws.ListECSLaunchSpecsInput) (*aws.ListECSLaunchSpecsOutput, error)
+
+	// CreateECSLaunchSpecFunc, if set, is called by CreateECSLaunchSpec.
+	CreateECSLaunchSpecFunc func(context.Context, *aws.CreateECSLaunchSpecInput) (*aws.CreateECSLaunchSpecOutput, error)
+
+	// ReadECSLaunchSpecFunc, if set, is called by ReadECSLaunchSpec.
+	ReadECSLaunchSpecFunc func(context.Context, *aws.ReadECSLaunchSpecInput) (*aws.ReadECSLaunchSpecOutput, error)
+
+	// UpdateECSLaunchSpecFunc, if set, is called by UpdateECSLaunchSpec.
+	UpdateECSLaunchSpecFunc func(context.Context, *aws.UpdateECSLaunchSpecInput) (*aws.UpdateECSLaunchSpecOutput, error)
+
+	// DeleteECSLaunchSpecFunc, if set, is called by DeleteECSLaunchSpec.
+	DeleteECSLaunchSpecFunc func(context.Context, *aws.DeleteECSLaunchSpecInput) (*aws.DeleteECSLaunchSpecOutput, error)
+
+	// RollECSFunc, if set, is called by RollECS.
+	RollECSFunc func(context.Context, *aws.ECSRollClusterInput) (*aws.ECSRollClusterOutput, error)
+
+	// ReadECSRollFunc, if set, is called by ReadECSRoll.
+	ReadECSRollFunc func(context.Context, *aws.ReadECSRollInput) (*aws.ReadECSRollOutput, error)
+}
+
+var _ aws.Service = (*Service)(nil)
+
+// ListClusters records the call and returns the configured results.
+func (m *Service) ListClusters(ctx context.Context, input *aws.ListClustersInput) (*aws.ListClustersOutput, error) {
+	m.Record("ListClusters", ctx, input)
+	if m.ListClustersFunc != nil {
+		return m.ListClustersFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ListClustersOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListClusters", 2); ok {
+		if r0, ok = rs[0].(*aws.ListClustersOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListClusters returns *aws.ListClustersOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListClusters returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ListClustersOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateCluster records the call and returns the configured results.
+func (m *Service) CreateCluster(ctx context.Context, input *aws.CreateClusterInput) (*aws.CreateClusterOutput, error) {
+	m.Record("CreateCluster", ctx, input)
+	if m.CreateClusterFunc != nil {
+		return m.CreateClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.CreateClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.CreateClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateCluster returns *aws.CreateClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.CreateClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadCluster records the call and returns the configured results.
+func (m *Service) ReadCluster(ctx context.Context, input *aws.ReadClusterInput) (*aws.ReadClusterOutput, error) {
+	m.Record("ReadCluster", ctx, input)
+	if m.ReadClusterFunc != nil {
+		return m.ReadClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadCluster returns *aws.ReadClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateCluster records the call and returns the configured results.
+func (m *Service) UpdateCluster(ctx context.Context, input *aws.UpdateClusterInput) (*aws.UpdateClusterOutput, error) {
+	m.Record("UpdateCluster", ctx, input)
+	if m.UpdateClusterFunc != nil {
+		return m.UpdateClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.UpdateClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.UpdateClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateCluster returns *aws.UpdateClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.UpdateClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteCluster records the call and returns the configured results.
+func (m *Service) DeleteCluster(ctx context.Context, input *aws.DeleteClusterInput) (*aws.DeleteClusterOutput, error) {
+	m.Record("DeleteCluster", ctx, input)
+	if m.DeleteClusterFunc != nil {
+		return m.DeleteClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DeleteClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.DeleteClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteCluster returns *aws.DeleteClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DeleteClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ListLaunchSpecs records the call and returns the configured results.
+func (m *Service) ListLaunchSpecs(ctx context.Context, input *aws.ListLaunchSpecsInput) (*aws.ListLaunchSpecsOutput, error) {
+	m.Record("ListLaunchSpecs", ctx, input)
+	if m.ListLaunchSpecsFunc != nil {
+		return m.ListLaunchSpecsFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ListLaunchSpecsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListLaunchSpecs", 2); ok {
+		if r0, ok = rs[0].(*aws.ListLaunchSpecsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListLaunchSpecs returns *aws.ListLaunchSpecsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListLaunchSpecs returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ListLaunchSpecsOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateLaunchSpec records the call and returns the configured results.
+func (m *Service) CreateLaunchSpec(ctx context.Context, input *aws.CreateLaunchSpecInput) (*aws.CreateLaunchSpecOutput, error) {
+	m.Record("CreateLaunchSpec", ctx, input)
+	if m.CreateLaunchSpecFunc != nil {
+		return m.CreateLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.CreateLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.CreateLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateLaunchSpec returns *aws.CreateLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.CreateLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadLaunchSpec records the call and returns the configured results.
+func (m *Service) ReadLaunchSpec(ctx context.Context, input *aws.ReadLaunchSpecInput) (*aws.ReadLaunchSpecOutput, error) {
+	m.Record("ReadLaunchSpec", ctx, input)
+	if m.ReadLaunchSpecFunc != nil {
+		return m.ReadLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadLaunchSpec returns *aws.ReadLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateLaunchSpec records the call and returns the configured results.
+func (m *Service) UpdateLaunchSpec(ctx context.Context, input *aws.UpdateLaunchSpecInput) (*aws.UpdateLaunchSpecOutput, error) {
+	m.Record("UpdateLaunchSpec", ctx, input)
+	if m.UpdateLaunchSpecFunc != nil {
+		return m.UpdateLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.UpdateLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.UpdateLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateLaunchSpec returns *aws.UpdateLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.UpdateLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteLaunchSpec records the call and returns the configured results.
+func (m *Service) DeleteLaunchSpec(ctx context.Context, input *aws.DeleteLaunchSpecInput) (*aws.DeleteLaunchSpecOutput, error) {
+	m.Record("DeleteLaunchSpec", ctx, input)
+	if m.DeleteLaunchSpecFunc != nil {
+		return m.DeleteLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DeleteLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.DeleteLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteLaunchSpec returns *aws.DeleteLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DeleteLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// ListClusterInstances records the call and returns the configured results.
+func (m *Service) ListClusterInstances(ctx context.Context, input *aws.ListClusterInstancesInput) (*aws.ListClusterInstancesOutput, error) {
+	m.Record("ListClusterInstances", ctx, input)
+	if m.ListClusterInstancesFunc != nil {
+		return m.ListClusterInstancesFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ListClusterInstancesOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListClusterInstances", 2); ok {
+		if r0, ok = rs[0].(*aws.ListClusterInstancesOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListClusterInstances returns *aws.ListClusterInstancesOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListClusterInstances returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ListClusterInstancesOutput)
+	}
+
+	return r0, r1
+}
+
+// DetachClusterInstances records the call and returns the configured results.
+func (m *Service) DetachClusterInstances(ctx context.Context, input *aws.DetachClusterInstancesInput) (*aws.DetachClusterInstancesOutput, error) {
+	m.Record("DetachClusterInstances", ctx, input)
+	if m.DetachClusterInstancesFunc != nil {
+		return m.DetachClusterInstancesFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DetachClusterInstancesOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DetachClusterInstances", 2); ok {
+		if r0, ok = rs[0].(*aws.DetachClusterInstancesOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DetachClusterInstances returns *aws.DetachClusterInstancesOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DetachClusterInstances returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DetachClusterInstancesOutput)
+	}
+
+	return r0, r1
+}
+
+// Roll records the call and returns the configured results.
+func (m *Service) Roll(ctx context.Context, input *aws.RollClusterInput) (*aws.RollClusterOutput, error) {
+	m.Record("Roll", ctx, input)
+	if m.RollFunc != nil {
+		return m.RollFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.RollClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("Roll", 2); ok {
+		if r0, ok = rs[0].(*aws.RollClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: Roll returns *aws.RollClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: Roll returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.RollClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadRoll records the call and returns the configured results.
+func (m *Service) ReadRoll(ctx context.Context, input *aws.ReadRollInput) (*aws.ReadRollOutput, error) {
+	m.Record("ReadRoll", ctx, input)
+	if m.ReadRollFunc != nil {
+		return m.ReadRollFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadRollOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadRoll", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadRollOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadRoll returns *aws.ReadRollOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadRoll returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadRollOutput)
+	}
+
+	return r0, r1
+}
+
+// ListECSClusters records the call and returns the configured results.
+func (m *Service) ListECSClusters(ctx context.Context, input *aws.ListECSClustersInput) (*aws.ListECSClustersOutput, error) {
+	m.Record("ListECSClusters", ctx, input)
+	if m.ListECSClustersFunc != nil {
+		return m.ListECSClustersFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ListECSClustersOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListECSClusters", 2); ok {
+		if r0, ok = rs[0].(*aws.ListECSClustersOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListECSClusters returns *aws.ListECSClustersOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListECSClusters returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ListECSClustersOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateECSCluster records the call and returns the configured results.
+func (m *Service) CreateECSCluster(ctx context.Context, input *aws.CreateECSClusterInput) (*aws.CreateECSClusterOutput, error) {
+	m.Record("CreateECSCluster", ctx, input)
+	if m.CreateECSClusterFunc != nil {
+		return m.CreateECSClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.CreateECSClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateECSCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.CreateECSClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateECSCluster returns *aws.CreateECSClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateECSCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.CreateECSClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadECSCluster records the call and returns the configured results.
+func (m *Service) ReadECSCluster(ctx context.Context, input *aws.ReadECSClusterInput) (*aws.ReadECSClusterOutput, error) {
+	m.Record("ReadECSCluster", ctx, input)
+	if m.ReadECSClusterFunc != nil {
+		return m.ReadECSClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadECSClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadECSCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadECSClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadECSCluster returns *aws.ReadECSClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadECSCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadECSClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateECSCluster records the call and returns the configured results.
+func (m *Service) UpdateECSCluster(ctx context.Context, input *aws.UpdateECSClusterInput) (*aws.UpdateECSClusterOutput, error) {
+	m.Record("UpdateECSCluster", ctx, input)
+	if m.UpdateECSClusterFunc != nil {
+		return m.UpdateECSClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.UpdateECSClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateECSCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.UpdateECSClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateECSCluster returns *aws.UpdateECSClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateECSCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.UpdateECSClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteECSCluster records the call and returns the configured results.
+func (m *Service) DeleteECSCluster(ctx context.Context, input *aws.DeleteECSClusterInput) (*aws.DeleteECSClusterOutput, error) {
+	m.Record("DeleteECSCluster", ctx, input)
+	if m.DeleteECSClusterFunc != nil {
+		return m.DeleteECSClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DeleteECSClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteECSCluster", 2); ok {
+		if r0, ok = rs[0].(*aws.DeleteECSClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteECSCluster returns *aws.DeleteECSClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteECSCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DeleteECSClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ListECSLaunchSpecs records the call and returns the configured results.
+func (m *Service) ListECSLaunchSpecs(ctx context.Context, input *aws.ListECSLaunchSpecsInput) (*aws.ListECSLaunchSpecsOutput, error) {
+	m.Record("ListECSLaunchSpecs", ctx, input)
+	if m.ListECSLaunchSpecsFunc != nil {
+		return m.ListECSLaunchSpecsFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ListECSLaunchSpecsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListECSLaunchSpecs", 2); ok {
+		if r0, ok = rs[0].(*aws.ListECSLaunchSpecsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListECSLaunchSpecs returns *aws.ListECSLaunchSpecsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListECSLaunchSpecs returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ListECSLaunchSpecsOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateECSLaunchSpec records the call and returns the configured results.
+func (m *Service) CreateECSLaunchSpec(ctx context.Context, input *aws.CreateECSLaunchSpecInput) (*aws.CreateECSLaunchSpecOutput, error) {
+	m.Record("CreateECSLaunchSpec", ctx, input)
+	if m.CreateECSLaunchSpecFunc != nil {
+		return m.CreateECSLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.CreateECSLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateECSLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.CreateECSLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateECSLaunchSpec returns *aws.CreateECSLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateECSLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.CreateECSLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadECSLaunchSpec records the call and returns the configured results.
+func (m *Service) ReadECSLaunchSpec(ctx context.Context, input *aws.ReadECSLaunchSpecInput) (*aws.ReadECSLaunchSpecOutput, error) {
+	m.Record("ReadECSLaunchSpec", ctx, input)
+	if m.ReadECSLaunchSpecFunc != nil {
+		return m.ReadECSLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadECSLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadECSLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadECSLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadECSLaunchSpec returns *aws.ReadECSLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadECSLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadECSLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateECSLaunchSpec records the call and returns the configured results.
+func (m *Service) UpdateECSLaunchSpec(ctx context.Context, input *aws.UpdateECSLaunchSpecInput) (*aws.UpdateECSLaunchSpecOutput, error) {
+	m.Record("UpdateECSLaunchSpec", ctx, input)
+	if m.UpdateECSLaunchSpecFunc != nil {
+		return m.UpdateECSLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.UpdateECSLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateECSLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.UpdateECSLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateECSLaunchSpec returns *aws.UpdateECSLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateECSLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.UpdateECSLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteECSLaunchSpec records the call and returns the configured results.
+func (m *Service) DeleteECSLaunchSpec(ctx context.Context, input *aws.DeleteECSLaunchSpecInput) (*aws.DeleteECSLaunchSpecOutput, error) {
+	m.Record("DeleteECSLaunchSpec", ctx, input)
+	if m.DeleteECSLaunchSpecFunc != nil {
+		return m.DeleteECSLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.DeleteECSLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteECSLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*aws.DeleteECSLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteECSLaunchSpec returns *aws.DeleteECSLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteECSLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.DeleteECSLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// RollECS records the call and returns the configured results.
+func (m *Service) RollECS(ctx context.Context, input *aws.ECSRollClusterInput) (*aws.ECSRollClusterOutput, error) {
+	m.Record("RollECS", ctx, input)
+	if m.RollECSFunc != nil {
+		return m.RollECSFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ECSRollClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("RollECS", 2); ok {
+		if r0, ok = rs[0].(*aws.ECSRollClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: RollECS returns *aws.ECSRollClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: RollECS returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ECSRollClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadECSRoll records the call and returns the configured results.
+func (m *Service) ReadECSRoll(ctx context.Context, input *aws.ReadECSRollInput) (*aws.ReadECSRollOutput, error) {
+	m.Record("ReadECSRoll", ctx, input)
+	if m.ReadECSRollFunc != nil {
+		return m.ReadECSRollFunc(ctx, input)
+	}
+
+	var (
+		r0 *aws.ReadECSRollOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadECSRoll", 2); ok {
+		if r0, ok = rs[0].(*aws.ReadECSRollOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadECSRoll returns *aws.ReadECSRollOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadECSRoll returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(aws.ReadECSRollOutput)
+	}
+
+	return r0, r1
+}
@@ -42,6 +42,10 @@ type Cluster struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Strategy struct {
@@ -52,6 +56,7 @@ type Strategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Capacity struct {
@@ -61,6 +66,7 @@ type Capacity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Compute struct {
@@ -70,6 +76,7 @@ type Compute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceTypes struct {
@@ -78,6 +85,7 @@ type InstanceTypes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LaunchSpecification struct {
@@ -95,6 +103,7 @@ type LaunchSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type IAMInstanceProfile struct {
@@ -103,6 +112,7 @@ type IAMInstanceProfile struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LoadBalancer struct {
@@ -124,6 +134,7 @@ type AutoScaler struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScalerHeadroom struct {
@@ -134,6 +145,7 @@ type AutoScalerHeadroom struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScalerResourceLimits struct {
@@ -142,6 +154,7 @@ type AutoScalerResourceLimits struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScalerDown struct {
@@ -150,6 +163,7 @@ type AutoScalerDown struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListClustersInput struct{}
@@ -196,6 +210,15 @@ type RollClusterOutput struct {
 	RollClusterStatus *RollClusterStatus `json:"clusterDeploymentStatus,omitempty"`
 }
 
+type ReadRollInput struct {
+	ClusterID *string `json:"clusterId,omitempty"`
+	RollID    *string `json:"rollId,omitempty"`
+}
+
+type ReadRollOutput struct {
+	RollClusterStatus *RollClusterStatus `json:"clusterDeploymentStatus,omitempty"`
+}
+
 type Roll struct {
 	ClusterID           *string `json:"clusterId,omitempty"`
 	BatchSizePercentage *int    `json:"batchSizePercentage,omitempty"`
@@ -304,6 +327,10 @@ func (s *ServiceOp) ListClusters(ctx context.Context, input *ListClustersInput)
 }
 
 func (s *ServiceOp) CreateCluster(ctx context.Context, input *CreateClusterInput) (*CreateClusterOutput, error) {
+	if err := s.Client.ValidateCreate(input.Cluster); err != nil {
+		return nil, err
+	}
+
 	r := client.NewRequest(http.MethodPost, "/ocean/aws/k8s/cluster")
 	r.Obj = input
 
@@ -355,6 +382,10 @@ func (s *ServiceOp) ReadCluster(ctx context.Context, input *ReadClusterInput) (*
 }
 
 func (s *ServiceOp) UpdateCluster(ctx context.Context, input *UpdateClusterInput) (*UpdateClusterOutput, error) {
+	if err := s.Client.Validate(input.Cluster); err != nil {
+		return nil, err
+	}
+
 	path, err := uritemplates.Expand("/ocean/aws/k8s/cluster/{clusterId}", uritemplates.Values{
 		"clusterId": spotinst.StringValue(input.Cluster.ID),
 	})
@@ -363,10 +394,13 @@ func (s *ServiceOp) UpdateCluster(ctx context.Context, input *UpdateClusterInput
 	}
 
 	// We do NOT need the ID anymore, so let's drop it.
-	input.Cluster.ID = nil
+	in := *input
+	cluster := *input.Cluster
+	cluster.ID = nil
+	in.Cluster = &cluster
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -415,10 +449,13 @@ func (s *ServiceOp) Roll(ctx context.Context, input *RollClusterInput) (*RollClu
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Roll.ClusterID = nil
+	in := *input
+	roll := *input.Roll
+	roll.ClusterID = nil
+	in.Roll = &roll
 
 	r := client.NewRequest(http.MethodPost, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -439,12 +476,46 @@ func (s *ServiceOp) Roll(ctx context.Context, input *RollClusterInput) (*RollClu
 	return output, nil
 }
 
+func (s *ServiceOp) ReadRoll(ctx context.Context, input *ReadRollInput) (*ReadRollOutput, error) {
+	path, err := uritemplates.Expand("/ocean/aws/k8s/cluster/{clusterId}/roll/{rollId}", uritemplates.Values{
+		"clusterId": spotinst.StringValue(input.ClusterID),
+		"rollId":    spotinst.StringValue(input.RollID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := client.NewRequest(http.MethodGet, path)
+	resp, err := client.RequireOK(s.Client.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	rs, err := rollStatusesFromHttpResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(ReadRollOutput)
+	if len(rs) > 0 {
+		output.RollClusterStatus = rs[0]
+	}
+
+	return output, nil
+}
+
 // region Cluster
 
 func (o Cluster) MarshalJSON() ([]byte, error) {
 	type noMethod Cluster
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Cluster) UnmarshalJSON(b []byte) error {
+	type noMethod Cluster
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Cluster) SetId(v *string) *Cluster {
@@ -510,7 +581,12 @@ func (o *Cluster) SetAutoScaler(v *AutoScaler) *Cluster {
 func (o Strategy) MarshalJSON() ([]byte, error) {
 	type noMethod Strategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Strategy) UnmarshalJSON(b []byte) error {
+	type noMethod Strategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Strategy) SetSpotPercentage(v *float64) *Strategy {
@@ -548,7 +624,12 @@ func (o *Strategy) SetDrainingTimeout(v *int) *Strategy {
 func (o Capacity) MarshalJSON() ([]byte, error) {
 	type noMethod Capacity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Capacity) UnmarshalJSON(b []byte) error {
+	type noMethod Capacity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Capacity) SetMinimum(v *int) *Capacity {
@@ -579,7 +660,12 @@ func (o *Capacity) SetTarget(v *int) *Capacity {
 func (o Compute) MarshalJSON() ([]byte, error) {
 	type noMethod Compute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Compute) UnmarshalJSON(b []byte) error {
+	type noMethod Compute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Compute) SetInstanceTypes(v *InstanceTypes) *Compute {
@@ -610,7 +696,12 @@ func (o *Compute) SetSubnetIDs(v []string) *Compute {
 func (o InstanceTypes) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceTypes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceTypes) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceTypes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceTypes) SetWhitelist(v []string) *InstanceTypes {
@@ -634,7 +725,12 @@ func (o *InstanceTypes) SetBlacklist(v []string) *InstanceTypes {
 func (o LaunchSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod LaunchSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LaunchSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod LaunchSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LaunchSpecification) SetAssociatePublicIPAddress(v *bool) *LaunchSpecification {
@@ -746,7 +842,12 @@ func (o *LoadBalancer) SetType(v *string) *LoadBalancer {
 func (o IAMInstanceProfile) MarshalJSON() ([]byte, error) {
 	type noMethod IAMInstanceProfile
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *IAMInstanceProfile) UnmarshalJSON(b []byte) error {
+	type noMethod IAMInstanceProfile
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *IAMInstanceProfile) SetArn(v *string) *IAMInstanceProfile {
@@ -770,7 +871,12 @@ func (o *IAMInstanceProfile) SetName(v *string) *IAMInstanceProfile {
 func (o AutoScaler) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaler
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaler) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaler
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaler) SetIsEnabled(v *bool) *AutoScaler {
@@ -822,7 +928,12 @@ func (o *AutoScaler) SetDown(v *AutoScalerDown) *AutoScaler {
 func (o AutoScalerHeadroom) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScalerHeadroom
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScalerHeadroom) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScalerHeadroom
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScalerHeadroom) SetCPUPerUnit(v *int) *AutoScalerHeadroom {
@@ -860,7 +971,12 @@ func (o *AutoScalerHeadroom) SetNumOfUnits(v *int) *AutoScalerHeadroom {
 func (o AutoScalerResourceLimits) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScalerResourceLimits
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScalerResourceLimits) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScalerResourceLimits
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScalerResourceLimits) SetMaxVCPU(v *int) *AutoScalerResourceLimits {
@@ -884,7 +1000,12 @@ func (o *AutoScalerResourceLimits) SetMaxMemoryGiB(v *int) *AutoScalerResourceLi
 func (o AutoScalerDown) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScalerDown
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScalerDown) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScalerDown
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScalerDown) SetEvaluationPeriods(v *int) *AutoScalerDown {
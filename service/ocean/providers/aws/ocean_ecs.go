@@ -42,6 +42,10 @@ type ECSCluster struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type ECSStrategy struct {
@@ -50,6 +54,7 @@ type ECSStrategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSCapacity struct {
@@ -59,6 +64,7 @@ type ECSCapacity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSCompute struct {
@@ -68,6 +74,7 @@ type ECSCompute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSInstanceTypes struct {
@@ -75,6 +82,7 @@ type ECSInstanceTypes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSLaunchSpecification struct {
@@ -90,6 +98,7 @@ type ECSLaunchSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSIAMInstanceProfile struct {
@@ -98,6 +107,7 @@ type ECSIAMInstanceProfile struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSAutoScaler struct {
@@ -110,6 +120,7 @@ type ECSAutoScaler struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSAutoScalerHeadroom struct {
@@ -119,6 +130,7 @@ type ECSAutoScalerHeadroom struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSAutoScalerResourceLimits struct {
@@ -127,6 +139,7 @@ type ECSAutoScalerResourceLimits struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ECSAutoScalerDown struct {
@@ -134,6 +147,7 @@ type ECSAutoScalerDown struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListECSClustersInput struct{}
@@ -180,6 +194,15 @@ type ECSRollClusterOutput struct {
 	RollClusterStatus *ECSRollClusterStatus `json:"clusterDeploymentStatus,omitempty"`
 }
 
+type ReadECSRollInput struct {
+	ClusterID *string `json:"clusterId,omitempty"`
+	RollID    *string `json:"rollId,omitempty"`
+}
+
+type ReadECSRollOutput struct {
+	RollClusterStatus *ECSRollClusterStatus `json:"clusterDeploymentStatus,omitempty"`
+}
+
 type ECSRoll struct {
 	ClusterID           *string `json:"clusterId,omitempty"`
 	BatchSizePercentage *int    `json:"batchSizePercentage,omitempty"`
@@ -347,10 +370,13 @@ func (s *ServiceOp) UpdateECSCluster(ctx context.Context, input *UpdateECSCluste
 	}
 
 	// We do NOT need the ID anymore, so let's drop it.
-	input.Cluster.ID = nil
+	in := *input
+	cluster := *input.Cluster
+	cluster.ID = nil
+	in.Cluster = &cluster
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -399,10 +425,13 @@ func (s *ServiceOp) RollECS(ctx context.Context, input *ECSRollClusterInput) (*E
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Roll.ClusterID = nil
+	in := *input
+	roll := *input.Roll
+	roll.ClusterID = nil
+	in.Roll = &roll
 
 	r := client.NewRequest(http.MethodPost, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -423,12 +452,46 @@ func (s *ServiceOp) RollECS(ctx context.Context, input *ECSRollClusterInput) (*E
 	return output, nil
 }
 
+func (s *ServiceOp) ReadECSRoll(ctx context.Context, input *ReadECSRollInput) (*ReadECSRollOutput, error) {
+	path, err := uritemplates.Expand("/ocean/aws/ecs/cluster/{clusterId}/roll/{rollId}", uritemplates.Values{
+		"clusterId": spotinst.StringValue(input.ClusterID),
+		"rollId":    spotinst.StringValue(input.RollID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := client.NewRequest(http.MethodGet, path)
+	resp, err := client.RequireOK(s.Client.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	rs, err := ecsRollStatusesFromHttpResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(ReadECSRollOutput)
+	if len(rs) > 0 {
+		output.RollClusterStatus = rs[0]
+	}
+
+	return output, nil
+}
+
 // region Cluster
 
 func (o ECSCluster) MarshalJSON() ([]byte, error) {
 	type noMethod ECSCluster
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSCluster) UnmarshalJSON(b []byte) error {
+	type noMethod ECSCluster
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSCluster) SetId(v *string) *ECSCluster {
@@ -469,7 +532,12 @@ func (o *ECSCluster) SetECSStrategy(v *ECSStrategy) *ECSCluster {
 func (o ECSCapacity) MarshalJSON() ([]byte, error) {
 	type noMethod ECSCapacity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSCapacity) UnmarshalJSON(b []byte) error {
+	type noMethod ECSCapacity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSCapacity) SetMinimum(v *int) *ECSCapacity {
@@ -521,7 +589,12 @@ func (o *ECSCluster) SetAutoScaler(v *ECSAutoScaler) *ECSCluster {
 func (o ECSCompute) MarshalJSON() ([]byte, error) {
 	type noMethod ECSCompute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSCompute) UnmarshalJSON(b []byte) error {
+	type noMethod ECSCompute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSCompute) SetInstanceTypes(v *ECSInstanceTypes) *ECSCompute {
@@ -552,7 +625,12 @@ func (o *ECSCompute) SetSubnetIDs(v []string) *ECSCompute {
 func (o ECSStrategy) MarshalJSON() ([]byte, error) {
 	type noMethod ECSStrategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSStrategy) UnmarshalJSON(b []byte) error {
+	type noMethod ECSStrategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSStrategy) SetDrainingTimeout(v *int) *ECSStrategy {
@@ -576,7 +654,12 @@ func (o *ECSStrategy) SetUtilizeReservedInstances(v *bool) *ECSStrategy {
 func (o ECSInstanceTypes) MarshalJSON() ([]byte, error) {
 	type noMethod ECSInstanceTypes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSInstanceTypes) UnmarshalJSON(b []byte) error {
+	type noMethod ECSInstanceTypes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSInstanceTypes) SetWhitelist(v []string) *ECSInstanceTypes {
@@ -593,7 +676,12 @@ func (o *ECSInstanceTypes) SetWhitelist(v []string) *ECSInstanceTypes {
 func (o ECSLaunchSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod ECSLaunchSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSLaunchSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod ECSLaunchSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSLaunchSpecification) SetAssociatePublicIPAddress(v *bool) *ECSLaunchSpecification {
@@ -666,7 +754,12 @@ func (o *ECSLaunchSpecification) SetEBSOptimized(v *bool) *ECSLaunchSpecificatio
 func (o ECSIAMInstanceProfile) MarshalJSON() ([]byte, error) {
 	type noMethod ECSIAMInstanceProfile
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSIAMInstanceProfile) UnmarshalJSON(b []byte) error {
+	type noMethod ECSIAMInstanceProfile
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSIAMInstanceProfile) SetArn(v *string) *ECSIAMInstanceProfile {
@@ -690,7 +783,12 @@ func (o *ECSIAMInstanceProfile) SetName(v *string) *ECSIAMInstanceProfile {
 func (o ECSAutoScaler) MarshalJSON() ([]byte, error) {
 	type noMethod ECSAutoScaler
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSAutoScaler) UnmarshalJSON(b []byte) error {
+	type noMethod ECSAutoScaler
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSAutoScaler) SetIsEnabled(v *bool) *ECSAutoScaler {
@@ -742,7 +840,12 @@ func (o *ECSAutoScaler) SetDown(v *ECSAutoScalerDown) *ECSAutoScaler {
 func (o ECSAutoScalerHeadroom) MarshalJSON() ([]byte, error) {
 	type noMethod ECSAutoScalerHeadroom
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSAutoScalerHeadroom) UnmarshalJSON(b []byte) error {
+	type noMethod ECSAutoScalerHeadroom
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSAutoScalerHeadroom) SetCPUPerUnit(v *int) *ECSAutoScalerHeadroom {
@@ -773,7 +876,12 @@ func (o *ECSAutoScalerHeadroom) SetNumOfUnits(v *int) *ECSAutoScalerHeadroom {
 func (o ECSAutoScalerResourceLimits) MarshalJSON() ([]byte, error) {
 	type noMethod ECSAutoScalerResourceLimits
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSAutoScalerResourceLimits) UnmarshalJSON(b []byte) error {
+	type noMethod ECSAutoScalerResourceLimits
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSAutoScalerResourceLimits) SetMaxVCPU(v *int) *ECSAutoScalerResourceLimits {
@@ -797,7 +905,12 @@ func (o *ECSAutoScalerResourceLimits) SetMaxMemoryGiB(v *int) *ECSAutoScalerReso
 func (o ECSAutoScalerDown) MarshalJSON() ([]byte, error) {
 	type noMethod ECSAutoScalerDown
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *ECSAutoScalerDown) UnmarshalJSON(b []byte) error {
+	type noMethod ECSAutoScalerDown
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *ECSAutoScalerDown) SetMaxScaleDownPercentage(v *int) *ECSAutoScalerDown {
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/diff"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterValidate(t *testing.T) {
+	cluster := &Cluster{
+		Capacity: &Capacity{Minimum: spotinst.Int(1), Maximum: spotinst.Int(3), Target: spotinst.Int(4)},
+		Strategy: &Strategy{SpotPercentage: spotinst.Float64(150)},
+		Compute: &Compute{
+			InstanceTypes: &InstanceTypes{Whitelist: []string{"m5.large"}, Blacklist: []string{"t2.micro"}},
+		},
+	}
+
+	err := cluster.Validate()
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"capacity.target: must not be greater than maximum (4 > 3); "+
+		"strategy.spotPercentage: must be between 0 and 100, got 150; "+
+		"compute.instanceTypes.blacklist: must not be set along with whitelist")
+
+	// Partial clusters, e.g. updates, are valid.
+	assert.NoError(t, (&Cluster{Capacity: &Capacity{Target: spotinst.Int(4)}}).Validate())
+	assert.NoError(t, (*Cluster)(nil).Validate())
+
+	// Creates require the mandatory fields.
+	cluster = &Cluster{
+		Name:                spotinst.String("foo"),
+		ControllerClusterID: spotinst.String("bar"),
+		Region:              spotinst.String("us-west-2"),
+		Compute:             &Compute{},
+	}
+	assert.NoError(t, cluster.Validate())
+	assert.EqualError(t, cluster.ValidateCreate(), "spotinst: invalid resource: compute.subnetIds: must be set")
+
+	cluster.Compute.SetSubnetIDs([]string{"subnet-1"})
+	assert.NoError(t, cluster.ValidateCreate())
+
+	cluster.Compute = nil
+	assert.EqualError(t, cluster.ValidateCreate(), "spotinst: invalid resource: compute: must be set")
+}
+
+func TestClusterValidation(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	id, err := srv.Put("/ocean/aws/k8s/cluster", &Cluster{Name: spotinst.String("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := New(srv.Session(), new(spotinst.Config).WithValidation(true))
+	ctx := context.Background()
+
+	_, err = svc.CreateCluster(ctx, &CreateClusterInput{Cluster: &Cluster{Strategy: &Strategy{SpotPercentage: spotinst.Float64(-1)}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"name: must be set; controllerClusterId: must be set; region: must be set; compute: must be set; "+
+		"strategy.spotPercentage: must be between 0 and 100, got -1")
+
+	instanceTypes := new(InstanceTypes)
+	instanceTypes.SetWhitelist(nil)
+	_, err = svc.UpdateCluster(ctx, &UpdateClusterInput{Cluster: &Cluster{ID: spotinst.String(id), Compute: &Compute{InstanceTypes: instanceTypes}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: compute.instanceTypes.whitelist: must not be empty")
+
+	// Partial updates are valid.
+	_, err = svc.UpdateCluster(ctx, &UpdateClusterInput{Cluster: &Cluster{ID: spotinst.String(id), Capacity: &Capacity{Target: spotinst.Int(2)}}})
+	assert.NoError(t, err)
+}
+
+func TestApply(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	var rolls []string
+	srv.Handle(http.MethodPost, "/ocean/aws/k8s/cluster/{clusterId}/roll",
+		func(req *http.Request, body []byte) ([]interface{}, error) {
+			rolls = append(rolls, string(body))
+			return []interface{}{map[string]interface{}{"id": "scr-1"}}, nil
+		})
+
+	svc := New(srv.Session())
+	ctx := context.Background()
+
+	desired := func(imageID string) *Cluster {
+		cluster := new(Cluster)
+		cluster.SetName(spotinst.String("foo"))
+		cluster.SetCompute(&Compute{LaunchSpecification: &LaunchSpecification{ImageID: spotinst.String(imageID)}})
+		return cluster
+	}
+
+	out, err := Apply(ctx, svc, &ApplyClusterInput{Cluster: desired("ami-1")})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionCreated, out.Action)
+	id := spotinst.StringValue(out.Cluster.ID)
+	assert.NotEmpty(t, id)
+
+	// The API fills in defaults for the fields that the spec omits, which are
+	// left as they are.
+	defaults := &Cluster{
+		ID:       spotinst.String(id),
+		Strategy: &Strategy{SpotPercentage: spotinst.Float64(100)},
+	}
+	if _, err := svc.UpdateCluster(ctx, &UpdateClusterInput{Cluster: defaults}); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err = Apply(ctx, svc, &ApplyClusterInput{Cluster: desired("ami-1")})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionUnchanged, out.Action)
+	assert.Empty(t, out.Changes)
+
+	roll := &RollClusterInput{Roll: &Roll{BatchSizePercentage: spotinst.Int(50)}}
+	out, err = Apply(ctx, svc, &ApplyClusterInput{Cluster: desired("ami-2"), Roll: roll})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, diff.ActionUpdated, out.Action)
+	assert.Equal(t, "~ compute.launchSpecification.imageId: \"ami-1\" => \"ami-2\"", out.Changes.String())
+	assert.Equal(t, 100.0, spotinst.Float64Value(out.Cluster.Strategy.SpotPercentage))
+	assert.NotNil(t, out.Roll)
+	if assert.Len(t, rolls, 1) {
+		assert.JSONEq(t, `{"roll": {"batchSizePercentage": 50}}`, rolls[0])
+	}
+	assert.Nil(t, roll.Roll.ClusterID, "expected the roll input to be unchanged")
+}
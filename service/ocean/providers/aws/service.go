@@ -1,5 +1,8 @@
 package aws
 
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/mockgen
+//go:generate go run github.com/spotinst/spotinst-sdk-go/internal/deepcopygen
+
 import (
 	"context"
 
@@ -28,6 +31,8 @@ type Service interface {
 	DetachClusterInstances(context.Context, *DetachClusterInstancesInput) (*DetachClusterInstancesOutput, error)
 
 	Roll(context.Context, *RollClusterInput) (*RollClusterOutput, error)
+	ReadRoll(context.Context, *ReadRollInput) (*ReadRollOutput, error)
+
 	ListECSClusters(context.Context, *ListECSClustersInput) (*ListECSClustersOutput, error)
 	CreateECSCluster(context.Context, *CreateECSClusterInput) (*CreateECSClusterOutput, error)
 	ReadECSCluster(context.Context, *ReadECSClusterInput) (*ReadECSClusterOutput, error)
@@ -41,6 +46,7 @@ type Service interface {
 	DeleteECSLaunchSpec(context.Context, *DeleteECSLaunchSpecInput) (*DeleteECSLaunchSpecOutput, error)
 
 	RollECS(context.Context, *ECSRollClusterInput) (*ECSRollClusterOutput, error)
+	ReadECSRoll(context.Context, *ReadECSRollInput) (*ReadECSRollOutput, error)
 }
 
 type ServiceOp struct {
@@ -55,6 +61,6 @@ func New(sess *session.Session, cfgs ...*spotinst.Config) *ServiceOp {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
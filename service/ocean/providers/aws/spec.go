@@ -0,0 +1,12 @@
+package aws
+
+import "github.com/spotinst/spotinst-sdk-go/spotinst/spec"
+
+// Register the kinds of the resources of the service, to decode them from spec
+// files.
+func init() {
+	spec.Register("OceanAWS", (*Cluster)(nil))
+	spec.Register("OceanAWSLaunchSpec", (*LaunchSpec)(nil))
+	spec.Register("OceanECS", (*ECSCluster)(nil))
+	spec.Register("OceanECSLaunchSpec", (*ECSLaunchSpec)(nil))
+}
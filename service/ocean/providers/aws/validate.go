@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+)
+
+// Validate returns a validation.Errors listing the invalid fields of the
+// cluster, if any. Fields that are not set are not validated, so that partial
+// clusters, e.g. updates, can be validated as well.
+func (o *Cluster) Validate() error {
+	return o.validate(false)
+}
+
+// ValidateCreate is like Validate, but also requires the fields that must be
+// set to create a cluster.
+func (o *Cluster) ValidateCreate() error {
+	return o.validate(true)
+}
+
+func (o *Cluster) validate(create bool) error {
+	if o == nil {
+		return nil
+	}
+
+	v := new(validation.Validator)
+	if create {
+		v.Required("name", o.Name)
+		v.Required("controllerClusterId", o.ControllerClusterID)
+		v.Required("region", o.Region)
+		v.Present("compute", o.Compute != nil)
+		if o.Compute != nil {
+			v.Present("compute.subnetIds", o.Compute.SubnetIDs != nil)
+		}
+	}
+	if o.Capacity != nil {
+		v.Capacity("capacity", o.Capacity.Minimum, o.Capacity.Maximum, o.Capacity.Target)
+	}
+	if o.Strategy != nil {
+		v.Range("strategy.spotPercentage", o.Strategy.SpotPercentage, 0, 100)
+	}
+	if o.Compute != nil {
+		o.Compute.InstanceTypes.validate(v, "compute.instanceTypes")
+	}
+
+	return v.Err()
+}
+
+func (o *InstanceTypes) validate(v *validation.Validator, path string) {
+	if o == nil {
+		return
+	}
+
+	if (o.Whitelist != nil && len(o.Whitelist) == 0) || validation.IsNull(o.nullFields, "Whitelist") {
+		v.Errorf(validation.Field(path, "whitelist"), "must not be empty")
+	}
+	if len(o.Whitelist) > 0 && len(o.Blacklist) > 0 {
+		v.Errorf(validation.Field(path, "blacklist"), "must not be set along with whitelist")
+	}
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// WaitUntilRollFinished polls the ReadRoll of svc until the cluster roll
+// reaches a terminal state. It returns the final roll status, or a
+// *waiter.RollError if the roll failed or was stopped.
+func WaitUntilRollFinished(ctx context.Context, svc Service, clusterID, rollID string,
+	opts *waiter.Options) (*RollClusterStatus, error) {
+	var status *RollClusterStatus
+
+	err := waiter.Poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		output, err := svc.ReadRoll(ctx, &ReadRollInput{
+			ClusterID: spotinst.String(clusterID),
+			RollID:    spotinst.String(rollID),
+		})
+		if err != nil {
+			return false, err
+		}
+		if output.RollClusterStatus == nil {
+			return false, fmt.Errorf("spotinst: roll %q of cluster %q not found", rollID, clusterID)
+		}
+
+		status = output.RollClusterStatus
+		progress := waiter.Progress{
+			ID:    spotinst.StringValue(status.RollID),
+			State: spotinst.StringValue(status.RollStatus),
+		}
+		if status.Progress != nil {
+			progress.Value = spotinst.IntValue(status.Progress.Value)
+			progress.Unit = spotinst.StringValue(status.Progress.Unit)
+		}
+		opts.Report(progress)
+
+		return waiter.RollDone(clusterID, rollID, progress.State)
+	})
+
+	return status, err
+}
+
+// WaitUntilECSRollFinished polls the ReadECSRoll of svc until the ECS cluster
+// roll reaches a terminal state. It returns the final roll status, or a
+// *waiter.RollError if the roll failed or was stopped.
+func WaitUntilECSRollFinished(ctx context.Context, svc Service, clusterID, rollID string,
+	opts *waiter.Options) (*ECSRollClusterStatus, error) {
+	var status *ECSRollClusterStatus
+
+	err := waiter.Poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		output, err := svc.ReadECSRoll(ctx, &ReadECSRollInput{
+			ClusterID: spotinst.String(clusterID),
+			RollID:    spotinst.String(rollID),
+		})
+		if err != nil {
+			return false, err
+		}
+		if output.RollClusterStatus == nil {
+			return false, fmt.Errorf("spotinst: roll %q of cluster %q not found", rollID, clusterID)
+		}
+
+		status = output.RollClusterStatus
+		progress := waiter.Progress{
+			ID:    spotinst.StringValue(status.RollID),
+			State: spotinst.StringValue(status.RollStatus),
+		}
+		if status.Progress != nil {
+			progress.Value = spotinst.IntValue(status.Progress.Value)
+			progress.Unit = spotinst.StringValue(status.Progress.Unit)
+		}
+		opts.Report(progress)
+
+		return waiter.RollDone(clusterID, rollID, progress.State)
+	})
+
+	return status, err
+}
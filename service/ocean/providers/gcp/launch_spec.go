@@ -36,6 +36,10 @@ type LaunchSpec struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Label struct {
@@ -44,6 +48,7 @@ type Label struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Taint struct {
@@ -53,6 +58,7 @@ type Taint struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScale struct {
@@ -60,6 +66,7 @@ type AutoScale struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaleHeadroom struct {
@@ -70,6 +77,7 @@ type AutoScaleHeadroom struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListLaunchSpecsInput struct {
@@ -226,10 +234,13 @@ func (s *ServiceOp) UpdateLaunchSpec(ctx context.Context, input *UpdateLaunchSpe
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.LaunchSpec.ID = nil
+	in := *input
+	launchSpec := *input.LaunchSpec
+	launchSpec.ID = nil
+	in.LaunchSpec = &launchSpec
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -304,7 +315,12 @@ func (s *ServiceOp) ImportOceanGKELaunchSpec(ctx context.Context, input *ImportO
 func (o LaunchSpec) MarshalJSON() ([]byte, error) {
 	type noMethod LaunchSpec
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LaunchSpec) UnmarshalJSON(b []byte) error {
+	type noMethod LaunchSpec
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *LaunchSpec) SetId(v *string) *LaunchSpec {
@@ -363,7 +379,12 @@ func (o *LaunchSpec) SetAutoScale(v *AutoScale) *LaunchSpec {
 func (o Label) MarshalJSON() ([]byte, error) {
 	type noMethod Label
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Label) UnmarshalJSON(b []byte) error {
+	type noMethod Label
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Label) SetKey(v *string) *Label {
@@ -387,7 +408,12 @@ func (o *Label) SetValue(v *string) *Label {
 func (o Taint) MarshalJSON() ([]byte, error) {
 	type noMethod Taint
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Taint) UnmarshalJSON(b []byte) error {
+	type noMethod Taint
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Taint) SetKey(v *string) *Taint {
@@ -432,7 +458,12 @@ type ImportOceanGKELaunchSpecOutput struct {
 func (o AutoScale) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScale
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScale) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScale
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScale) SetHeadrooms(v []*AutoScaleHeadroom) *AutoScale {
@@ -449,7 +480,12 @@ func (o *AutoScale) SetHeadrooms(v []*AutoScaleHeadroom) *AutoScale {
 func (o AutoScaleHeadroom) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaleHeadroom
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaleHeadroom) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaleHeadroom
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaleHeadroom) SetCPUPerUnit(v *int) *AutoScaleHeadroom {
@@ -0,0 +1,360 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock of gcp.Service.
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/mock"
+)
+
+// Service is a mock of gcp.Service. Its zero value is ready to use.
+//
+// Calls are recorded, and can be inspected with Calls. Each method returns, in
+// order of precedence: the results of its function override (e.g. ListClustersFunc)
+// if set, the canned values registered with Returns or ReturnsOnce, or zero
+// values, with pointer results set to new values.
+type Service struct {
+	mock.Mock
+
+	// ListClustersFunc, if set, is called by ListClusters.
+	ListClustersFunc func(context.Context, *gcp.ListClustersInput) (*gcp.ListClustersOutput, error)
+
+	// CreateClusterFunc, if set, is called by CreateCluster.
+	CreateClusterFunc func(context.Context, *gcp.CreateClusterInput) (*gcp.CreateClusterOutput, error)
+
+	// ReadClusterFunc, if set, is called by ReadCluster.
+	ReadClusterFunc func(context.Context, *gcp.ReadClusterInput) (*gcp.ReadClusterOutput, error)
+
+	// UpdateClusterFunc, if set, is called by UpdateCluster.
+	UpdateClusterFunc func(context.Context, *gcp.UpdateClusterInput) (*gcp.UpdateClusterOutput, error)
+
+	// DeleteClusterFunc, if set, is called by DeleteCluster.
+	DeleteClusterFunc func(context.Context, *gcp.DeleteClusterInput) (*gcp.DeleteClusterOutput, error)
+
+	// ListLaunchSpecsFunc, if set, is called by ListLaunchSpecs.
+	ListLaunchSpecsFunc func(context.Context, *gcp.ListLaunchSpecsInput) (*gcp.ListLaunchSpecsOutput, error)
+
+	// CreateLaunchSpecFunc, if set, is called by CreateLaunchSpec.
+	CreateLaunchSpecFunc func(context.Context, *gcp.CreateLaunchSpecInput) (*gcp.CreateLaunchSpecOutput, error)
+
+	// ReadLaunchSpecFunc, if set, is called by ReadLaunchSpec.
+	ReadLaunchSpecFunc func(context.Context, *gcp.ReadLaunchSpecInput) (*gcp.ReadLaunchSpecOutput, error)
+
+	// UpdateLaunchSpecFunc, if set, is called by UpdateLaunchSpec.
+	UpdateLaunchSpecFunc func(context.Context, *gcp.UpdateLaunchSpecInput) (*gcp.UpdateLaunchSpecOutput, error)
+
+	// DeleteLaunchSpecFunc, if set, is called by DeleteLaunchSpec.
+	DeleteLaunchSpecFunc func(context.Context, *gcp.DeleteLaunchSpecInput) (*gcp.DeleteLaunchSpecOutput, error)
+
+	// ImportOceanGKEClusterFunc, if set, is called by ImportOceanGKECluster.
+	ImportOceanGKEClusterFunc func(context.Context, *gcp.ImportOceanGKEClusterInput) (*gcp.ImportOceanGKEClusterOutput, error)
+
+	// ImportOceanGKELaunchSpecFunc, if set, is called by ImportOceanGKELaunchSpec.
+	ImportOceanGKELaunchSpecFunc func(context.Context, *gcp.ImportOceanGKELaunchSpecInput) (*gcp.ImportOceanGKELaunchSpecOutput, error)
+}
+
+var _ gcp.Service = (*Service)(nil)
+
+// ListClusters records the call and returns the configured results.
+func (m *Service) ListClusters(ctx context.Context, input *gcp.ListClustersInput) (*gcp.ListClustersOutput, error) {
+	m.Record("ListClusters", ctx, input)
+	if m.ListClustersFunc != nil {
+		return m.ListClustersFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ListClustersOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListClusters", 2); ok {
+		if r0, ok = rs[0].(*gcp.ListClustersOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListClusters returns *gcp.ListClustersOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListClusters returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ListClustersOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateCluster records the call and returns the configured results.
+func (m *Service) CreateCluster(ctx context.Context, input *gcp.CreateClusterInput) (*gcp.CreateClusterOutput, error) {
+	m.Record("CreateCluster", ctx, input)
+	if m.CreateClusterFunc != nil {
+		return m.CreateClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.CreateClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateCluster", 2); ok {
+		if r0, ok = rs[0].(*gcp.CreateClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateCluster returns *gcp.CreateClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.CreateClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadCluster records the call and returns the configured results.
+func (m *Service) ReadCluster(ctx context.Context, input *gcp.ReadClusterInput) (*gcp.ReadClusterOutput, error) {
+	m.Record("ReadCluster", ctx, input)
+	if m.ReadClusterFunc != nil {
+		return m.ReadClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ReadClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadCluster", 2); ok {
+		if r0, ok = rs[0].(*gcp.ReadClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadCluster returns *gcp.ReadClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ReadClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateCluster records the call and returns the configured results.
+func (m *Service) UpdateCluster(ctx context.Context, input *gcp.UpdateClusterInput) (*gcp.UpdateClusterOutput, error) {
+	m.Record("UpdateCluster", ctx, input)
+	if m.UpdateClusterFunc != nil {
+		return m.UpdateClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.UpdateClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateCluster", 2); ok {
+		if r0, ok = rs[0].(*gcp.UpdateClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateCluster returns *gcp.UpdateClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.UpdateClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteCluster records the call and returns the configured results.
+func (m *Service) DeleteCluster(ctx context.Context, input *gcp.DeleteClusterInput) (*gcp.DeleteClusterOutput, error) {
+	m.Record("DeleteCluster", ctx, input)
+	if m.DeleteClusterFunc != nil {
+		return m.DeleteClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.DeleteClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteCluster", 2); ok {
+		if r0, ok = rs[0].(*gcp.DeleteClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteCluster returns *gcp.DeleteClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteCluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.DeleteClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ListLaunchSpecs records the call and returns the configured results.
+func (m *Service) ListLaunchSpecs(ctx context.Context, input *gcp.ListLaunchSpecsInput) (*gcp.ListLaunchSpecsOutput, error) {
+	m.Record("ListLaunchSpecs", ctx, input)
+	if m.ListLaunchSpecsFunc != nil {
+		return m.ListLaunchSpecsFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ListLaunchSpecsOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ListLaunchSpecs", 2); ok {
+		if r0, ok = rs[0].(*gcp.ListLaunchSpecsOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ListLaunchSpecs returns *gcp.ListLaunchSpecsOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ListLaunchSpecs returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ListLaunchSpecsOutput)
+	}
+
+	return r0, r1
+}
+
+// CreateLaunchSpec records the call and returns the configured results.
+func (m *Service) CreateLaunchSpec(ctx context.Context, input *gcp.CreateLaunchSpecInput) (*gcp.CreateLaunchSpecOutput, error) {
+	m.Record("CreateLaunchSpec", ctx, input)
+	if m.CreateLaunchSpecFunc != nil {
+		return m.CreateLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.CreateLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("CreateLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*gcp.CreateLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: CreateLaunchSpec returns *gcp.CreateLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: CreateLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.CreateLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// ReadLaunchSpec records the call and returns the configured results.
+func (m *Service) ReadLaunchSpec(ctx context.Context, input *gcp.ReadLaunchSpecInput) (*gcp.ReadLaunchSpecOutput, error) {
+	m.Record("ReadLaunchSpec", ctx, input)
+	if m.ReadLaunchSpecFunc != nil {
+		return m.ReadLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ReadLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ReadLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*gcp.ReadLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ReadLaunchSpec returns *gcp.ReadLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ReadLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ReadLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// UpdateLaunchSpec records the call and returns the configured results.
+func (m *Service) UpdateLaunchSpec(ctx context.Context, input *gcp.UpdateLaunchSpecInput) (*gcp.UpdateLaunchSpecOutput, error) {
+	m.Record("UpdateLaunchSpec", ctx, input)
+	if m.UpdateLaunchSpecFunc != nil {
+		return m.UpdateLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.UpdateLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("UpdateLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*gcp.UpdateLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: UpdateLaunchSpec returns *gcp.UpdateLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: UpdateLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.UpdateLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// DeleteLaunchSpec records the call and returns the configured results.
+func (m *Service) DeleteLaunchSpec(ctx context.Context, input *gcp.DeleteLaunchSpecInput) (*gcp.DeleteLaunchSpecOutput, error) {
+	m.Record("DeleteLaunchSpec", ctx, input)
+	if m.DeleteLaunchSpecFunc != nil {
+		return m.DeleteLaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.DeleteLaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("DeleteLaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*gcp.DeleteLaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: DeleteLaunchSpec returns *gcp.DeleteLaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: DeleteLaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.DeleteLaunchSpecOutput)
+	}
+
+	return r0, r1
+}
+
+// ImportOceanGKECluster records the call and returns the configured results.
+func (m *Service) ImportOceanGKECluster(ctx context.Context, input *gcp.ImportOceanGKEClusterInput) (*gcp.ImportOceanGKEClusterOutput, error) {
+	m.Record("ImportOceanGKECluster", ctx, input)
+	if m.ImportOceanGKEClusterFunc != nil {
+		return m.ImportOceanGKEClusterFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ImportOceanGKEClusterOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ImportOceanGKECluster", 2); ok {
+		if r0, ok = rs[0].(*gcp.ImportOceanGKEClusterOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ImportOceanGKECluster returns *gcp.ImportOceanGKEClusterOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ImportOceanGKECluster returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ImportOceanGKEClusterOutput)
+	}
+
+	return r0, r1
+}
+
+// ImportOceanGKELaunchSpec records the call and returns the configured results.
+func (m *Service) ImportOceanGKELaunchSpec(ctx context.Context, input *gcp.ImportOceanGKELaunchSpecInput) (*gcp.ImportOceanGKELaunchSpecOutput, error) {
+	m.Record("ImportOceanGKELaunchSpec", ctx, input)
+	if m.ImportOceanGKELaunchSpecFunc != nil {
+		return m.ImportOceanGKELaunchSpecFunc(ctx, input)
+	}
+
+	var (
+		r0 *gcp.ImportOceanGKELaunchSpecOutput
+		r1 error
+	)
+	if rs, ok := m.Result("ImportOceanGKELaunchSpec", 2); ok {
+		if r0, ok = rs[0].(*gcp.ImportOceanGKELaunchSpecOutput); !ok && rs[0] != nil {
+			panic(fmt.Sprintf("mock: ImportOceanGKELaunchSpec returns *gcp.ImportOceanGKELaunchSpecOutput as result 0, got canned value of type %T", rs[0]))
+		}
+		if r1, ok = rs[1].(error); !ok && rs[1] != nil {
+			panic(fmt.Sprintf("mock: ImportOceanGKELaunchSpec returns error as result 1, got canned value of type %T", rs[1]))
+		}
+	} else {
+		r0 = new(gcp.ImportOceanGKELaunchSpecOutput)
+	}
+
+	return r0, r1
+}
@@ -41,6 +41,10 @@ type Cluster struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type Strategy struct {
@@ -48,6 +52,7 @@ type Strategy struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScaler struct {
@@ -60,6 +65,7 @@ type AutoScaler struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScalerDown struct {
@@ -68,6 +74,7 @@ type AutoScalerDown struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScalerHeadroom struct {
@@ -78,6 +85,7 @@ type AutoScalerHeadroom struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AutoScalerResourceLimits struct {
@@ -86,6 +94,7 @@ type AutoScalerResourceLimits struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type BackendService struct {
@@ -96,6 +105,7 @@ type BackendService struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Capacity struct {
@@ -105,6 +115,7 @@ type Capacity struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Compute struct {
@@ -117,6 +128,7 @@ type Compute struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type GKE struct {
@@ -125,6 +137,7 @@ type GKE struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type InstanceTypes struct {
@@ -132,6 +145,7 @@ type InstanceTypes struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type LaunchSpecification struct {
@@ -145,6 +159,7 @@ type LaunchSpecification struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type Metadata struct {
@@ -153,6 +168,7 @@ type Metadata struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type NamedPorts struct {
@@ -161,6 +177,7 @@ type NamedPorts struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type NetworkInterface struct {
@@ -170,6 +187,7 @@ type NetworkInterface struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AccessConfig struct {
@@ -178,6 +196,7 @@ type AccessConfig struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type AliasIPRange struct {
@@ -186,6 +205,7 @@ type AliasIPRange struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 type ListClustersInput struct{}
@@ -311,6 +331,10 @@ func (s *ServiceOp) ListClusters(ctx context.Context, input *ListClustersInput)
 }
 
 func (s *ServiceOp) CreateCluster(ctx context.Context, input *CreateClusterInput) (*CreateClusterOutput, error) {
+	if err := s.Client.ValidateCreate(input.Cluster); err != nil {
+		return nil, err
+	}
+
 	r := client.NewRequest(http.MethodPost, "/ocean/gcp/k8s/cluster")
 	r.Obj = input
 
@@ -362,6 +386,10 @@ func (s *ServiceOp) ReadCluster(ctx context.Context, input *ReadClusterInput) (*
 }
 
 func (s *ServiceOp) UpdateCluster(ctx context.Context, input *UpdateClusterInput) (*UpdateClusterOutput, error) {
+	if err := s.Client.Validate(input.Cluster); err != nil {
+		return nil, err
+	}
+
 	path, err := uritemplates.Expand("/ocean/gcp/k8s/cluster/{clusterId}", uritemplates.Values{
 		"clusterId": spotinst.StringValue(input.Cluster.ID),
 	})
@@ -370,10 +398,13 @@ func (s *ServiceOp) UpdateCluster(ctx context.Context, input *UpdateClusterInput
 	}
 
 	// We do NOT need the ID anymore, so let's drop it.
-	input.Cluster.ID = nil
+	in := *input
+	cluster := *input.Cluster
+	cluster.ID = nil
+	in.Cluster = &cluster
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -447,7 +478,12 @@ func (s *ServiceOp) ImportOceanGKECluster(ctx context.Context, input *ImportOcea
 func (o Cluster) MarshalJSON() ([]byte, error) {
 	type noMethod Cluster
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Cluster) UnmarshalJSON(b []byte) error {
+	type noMethod Cluster
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Cluster) SetId(v *string) *Cluster {
@@ -513,7 +549,12 @@ func (o *Cluster) SetGKE(v *GKE) *Cluster {
 func (o GKE) MarshalJSON() ([]byte, error) {
 	type noMethod GKE
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *GKE) UnmarshalJSON(b []byte) error {
+	type noMethod GKE
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *GKE) SetClusterName(v *string) *GKE {
@@ -561,7 +602,12 @@ type ImportOceanGKEClusterOutput struct {
 func (o Capacity) MarshalJSON() ([]byte, error) {
 	type noMethod Capacity
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Capacity) UnmarshalJSON(b []byte) error {
+	type noMethod Capacity
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Capacity) SetMinimum(v *int) *Capacity {
@@ -592,7 +638,12 @@ func (o *Capacity) SetTarget(v *int) *Capacity {
 func (o Strategy) MarshalJSON() ([]byte, error) {
 	type noMethod Strategy
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Strategy) UnmarshalJSON(b []byte) error {
+	type noMethod Strategy
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Strategy) SetDrainingTimeout(v *int) *Strategy {
@@ -609,7 +660,12 @@ func (o *Strategy) SetDrainingTimeout(v *int) *Strategy {
 func (o Compute) MarshalJSON() ([]byte, error) {
 	type noMethod Compute
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Compute) UnmarshalJSON(b []byte) error {
+	type noMethod Compute
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Compute) SetInstanceTypes(v *InstanceTypes) *Compute {
@@ -654,7 +710,12 @@ func (o *Compute) SetSubnetName(v *string) *Compute {
 func (o InstanceTypes) MarshalJSON() ([]byte, error) {
 	type noMethod InstanceTypes
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *InstanceTypes) UnmarshalJSON(b []byte) error {
+	type noMethod InstanceTypes
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *InstanceTypes) SetWhitelist(v []string) *InstanceTypes {
@@ -671,7 +732,12 @@ func (o *InstanceTypes) SetWhitelist(v []string) *InstanceTypes {
 func (o LaunchSpecification) MarshalJSON() ([]byte, error) {
 	type noMethod LaunchSpecification
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *LaunchSpecification) UnmarshalJSON(b []byte) error {
+	type noMethod LaunchSpecification
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (c *Compute) SetBackendServices(v []*BackendService) *Compute {
@@ -737,7 +803,12 @@ func (o *LaunchSpecification) SetTags(v []string) *LaunchSpecification {
 func (o BackendService) MarshalJSON() ([]byte, error) {
 	type noMethod BackendService
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *BackendService) UnmarshalJSON(b []byte) error {
+	type noMethod BackendService
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *BackendService) SetBackendServiceName(v *string) *BackendService {
@@ -775,7 +846,12 @@ func (o *BackendService) SetNamedPorts(v *NamedPorts) *BackendService {
 func (o NamedPorts) MarshalJSON() ([]byte, error) {
 	type noMethod NamedPorts
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NamedPorts) UnmarshalJSON(b []byte) error {
+	type noMethod NamedPorts
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 // SetNamedPorts sets the name of the NamedPorts
@@ -800,7 +876,12 @@ func (o *NamedPorts) SetPorts(v []int) *NamedPorts {
 func (o Metadata) MarshalJSON() ([]byte, error) {
 	type noMethod Metadata
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Metadata) UnmarshalJSON(b []byte) error {
+	type noMethod Metadata
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Metadata) SetKey(v *string) *Metadata {
@@ -824,7 +905,12 @@ func (o *Metadata) SetValue(v *string) *Metadata {
 func (o NetworkInterface) MarshalJSON() ([]byte, error) {
 	type noMethod NetworkInterface
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *NetworkInterface) UnmarshalJSON(b []byte) error {
+	type noMethod NetworkInterface
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *NetworkInterface) SetAccessConfigs(v []*AccessConfig) *NetworkInterface {
@@ -855,7 +941,12 @@ func (o *NetworkInterface) SetNetwork(v *string) *NetworkInterface {
 func (o AliasIPRange) MarshalJSON() ([]byte, error) {
 	type noMethod AliasIPRange
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AliasIPRange) UnmarshalJSON(b []byte) error {
+	type noMethod AliasIPRange
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AliasIPRange) SetIPCIDRRange(v *string) *AliasIPRange {
@@ -879,7 +970,12 @@ func (o *AliasIPRange) SetSubnetworkRangeName(v *string) *AliasIPRange {
 func (o AccessConfig) MarshalJSON() ([]byte, error) {
 	type noMethod AccessConfig
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AccessConfig) UnmarshalJSON(b []byte) error {
+	type noMethod AccessConfig
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AccessConfig) SetName(v *string) *AccessConfig {
@@ -903,7 +999,12 @@ func (o *AccessConfig) SetType(v *string) *AccessConfig {
 func (o AutoScaler) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScaler
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScaler) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScaler
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScaler) SetIsEnabled(v *bool) *AutoScaler {
@@ -955,7 +1056,12 @@ func (o *AutoScaler) SetDown(v *AutoScalerDown) *AutoScaler {
 func (o AutoScalerHeadroom) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScalerHeadroom
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScalerHeadroom) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScalerHeadroom
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScalerHeadroom) SetCPUPerUnit(v *int) *AutoScalerHeadroom {
@@ -994,7 +1100,12 @@ func (o *AutoScalerHeadroom) SetGPUPerUnit(v *int) *AutoScalerHeadroom {
 func (o AutoScalerResourceLimits) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScalerResourceLimits
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScalerResourceLimits) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScalerResourceLimits
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScalerResourceLimits) SetMaxVCPU(v *int) *AutoScalerResourceLimits {
@@ -1018,7 +1129,12 @@ func (o *AutoScalerResourceLimits) SetMaxMemoryGiB(v *int) *AutoScalerResourceLi
 func (o AutoScalerDown) MarshalJSON() ([]byte, error) {
 	type noMethod AutoScalerDown
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *AutoScalerDown) UnmarshalJSON(b []byte) error {
+	type noMethod AutoScalerDown
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *AutoScalerDown) SetEvaluationPeriods(v *int) *AutoScalerDown {
@@ -0,0 +1,68 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterValidate(t *testing.T) {
+	cluster := &Cluster{
+		Capacity: &Capacity{Minimum: spotinst.Int(-1)},
+		Compute:  &Compute{InstanceTypes: &InstanceTypes{Whitelist: []string{}}},
+	}
+
+	err := cluster.Validate()
+	assert.True(t, errors.Is(err, validation.ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"capacity.minimum: must not be negative, got -1; "+
+		"compute.instanceTypes.whitelist: must not be empty")
+
+	// Partial clusters, e.g. updates, are valid.
+	assert.NoError(t, (&Cluster{Capacity: &Capacity{Maximum: spotinst.Int(3)}}).Validate())
+	assert.NoError(t, (*Cluster)(nil).Validate())
+
+	// Creates require the mandatory fields.
+	cluster = &Cluster{
+		Name:                spotinst.String("foo"),
+		ControllerClusterID: spotinst.String("bar"),
+		GKE:                 &GKE{ClusterName: spotinst.String("baz")},
+	}
+	assert.NoError(t, cluster.Validate())
+	assert.EqualError(t, cluster.ValidateCreate(), "spotinst: invalid resource: gke.masterLocation: must be set")
+
+	cluster.GKE.SetMasterLocation(spotinst.String("us-central1-a"))
+	assert.NoError(t, cluster.ValidateCreate())
+}
+
+func TestClusterValidation(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	id, err := srv.Put("/ocean/gcp/k8s/cluster", &Cluster{Name: spotinst.String("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := New(srv.Session(), new(spotinst.Config).WithValidation(true))
+	ctx := context.Background()
+
+	_, err = svc.CreateCluster(ctx, &CreateClusterInput{Cluster: &Cluster{Capacity: &Capacity{Minimum: spotinst.Int(3), Maximum: spotinst.Int(2)}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"name: must be set; controllerClusterId: must be set; gke: must be set; "+
+		"capacity.minimum: must not be greater than maximum (3 > 2)")
+
+	instanceTypes := new(InstanceTypes)
+	instanceTypes.SetWhitelist(nil)
+	_, err = svc.UpdateCluster(ctx, &UpdateClusterInput{Cluster: &Cluster{ID: spotinst.String(id), Compute: &Compute{InstanceTypes: instanceTypes}}})
+	assert.EqualError(t, err, "spotinst: invalid resource: compute.instanceTypes.whitelist: must not be empty")
+
+	// Partial updates are valid.
+	_, err = svc.UpdateCluster(ctx, &UpdateClusterInput{Cluster: &Cluster{ID: spotinst.String(id), Capacity: &Capacity{Target: spotinst.Int(2)}}})
+	assert.NoError(t, err)
+}
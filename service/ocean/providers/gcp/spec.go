@@ -0,0 +1,10 @@
+package gcp
+
+import "github.com/spotinst/spotinst-sdk-go/spotinst/spec"
+
+// Register the kinds of the resources of the service, to decode them from spec
+// files.
+func init() {
+	spec.Register("OceanGKE", (*Cluster)(nil))
+	spec.Register("OceanGKELaunchSpec", (*LaunchSpec)(nil))
+}
@@ -8,12 +8,18 @@ type Tag struct {
 
 	forceSendFields []string
 	nullFields      []string
+	unknownFields   jsonutil.UnknownFields
 }
 
 func (o Tag) MarshalJSON() ([]byte, error) {
 	type noMethod Tag
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Tag) UnmarshalJSON(b []byte) error {
+	type noMethod Tag
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Tag) SetKey(v *string) *Tag {
@@ -0,0 +1,46 @@
+package gcp
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+)
+
+// Validate returns a validation.Errors listing the invalid fields of the
+// cluster, if any. Fields that are not set are not validated, so that partial
+// clusters, e.g. updates, can be validated as well.
+func (o *Cluster) Validate() error {
+	return o.validate(false)
+}
+
+// ValidateCreate is like Validate, but also requires the fields that must be
+// set to create a cluster.
+func (o *Cluster) ValidateCreate() error {
+	return o.validate(true)
+}
+
+func (o *Cluster) validate(create bool) error {
+	if o == nil {
+		return nil
+	}
+
+	v := new(validation.Validator)
+	if create {
+		v.Required("name", o.Name)
+		v.Required("controllerClusterId", o.ControllerClusterID)
+		v.Present("gke", o.GKE != nil)
+		if o.GKE != nil {
+			v.Required("gke.clusterName", o.GKE.ClusterName)
+			v.Required("gke.masterLocation", o.GKE.MasterLocation)
+		}
+	}
+	if o.Capacity != nil {
+		v.Capacity("capacity", o.Capacity.Minimum, o.Capacity.Maximum, o.Capacity.Target)
+	}
+	if o.Compute != nil && o.Compute.InstanceTypes != nil {
+		it := o.Compute.InstanceTypes
+		if (it.Whitelist != nil && len(it.Whitelist) == 0) || validation.IsNull(it.nullFields, "Whitelist") {
+			v.Errorf("compute.instanceTypes.whitelist", "must not be empty")
+		}
+	}
+
+	return v.Err()
+}
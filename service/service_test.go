@@ -0,0 +1,176 @@
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup"
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	elastigroupazure "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	elastigroupgcp "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	"github.com/spotinst/spotinst-sdk-go/service/managedinstance"
+	managedinstanceaws "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/mcs"
+	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	oceangcp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/stretchr/testify/assert"
+)
+
+const testUserAgent = "constructor-test/1.0"
+
+// constructors calls an operation of the service client created by each
+// constructor, with the given session and per-service configuration.
+var constructors = map[string]func(context.Context, *session.Session, *spotinst.Config) error{
+	"elastigroup": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := elastigroup.New(sess, cfg).CloudProviderAWS().List(ctx, &elastigroupaws.ListGroupsInput{})
+		return err
+	},
+	"elastigroup/aws": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := elastigroupaws.New(sess, cfg).List(ctx, &elastigroupaws.ListGroupsInput{})
+		return err
+	},
+	"elastigroup/azure": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := elastigroupazure.New(sess, cfg).List(ctx, &elastigroupazure.ListGroupsInput{})
+		return err
+	},
+	"elastigroup/gcp": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := elastigroupgcp.New(sess, cfg).List(ctx, &elastigroupgcp.ListGroupsInput{})
+		return err
+	},
+	"ocean": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := ocean.New(sess, cfg).CloudProviderGCP().ListClusters(ctx, &oceangcp.ListClustersInput{})
+		return err
+	},
+	"ocean/aws": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := oceanaws.New(sess, cfg).ListClusters(ctx, &oceanaws.ListClustersInput{})
+		return err
+	},
+	"ocean/gcp": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := oceangcp.New(sess, cfg).ListClusters(ctx, &oceangcp.ListClustersInput{})
+		return err
+	},
+	"managedinstance": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := managedinstance.New(sess, cfg).CloudProviderAWS().List(ctx, &managedinstanceaws.ListManagedInstancesInput{})
+		return err
+	},
+	"managedinstance/aws": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := managedinstanceaws.New(sess, cfg).List(ctx, &managedinstanceaws.ListManagedInstancesInput{})
+		return err
+	},
+	"mrscaler": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := mrscaler.New(sess, cfg).List(ctx, &mrscaler.ListScalersInput{})
+		return err
+	},
+	"multai": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := multai.New(sess, cfg).ListLoadBalancers(ctx, &multai.ListLoadBalancersInput{})
+		return err
+	},
+	"healthcheck": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := healthcheck.New(sess, cfg).List(ctx, &healthcheck.ListHealthChecksInput{})
+		return err
+	},
+	"subscription": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := subscription.New(sess, cfg).List(ctx, &subscription.ListSubscriptionsInput{})
+		return err
+	},
+	"mcs": func(ctx context.Context, sess *session.Session, cfg *spotinst.Config) error {
+		_, err := mcs.New(sess, cfg).GetClusterCosts(ctx, &mcs.ClusterCostInput{ClusterID: spotinst.String("o-1")})
+		return err
+	},
+}
+
+// TestConstructorConfigOverrides verifies that every constructor honors the
+// per-service configuration over the session's one: the session points to an
+// unreachable endpoint with invalid credentials, so that calls only succeed if
+// the base URL, HTTP client and credentials of the override are used.
+func TestConstructorConfigOverrides(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	empty := func(*http.Request, []byte) ([]interface{}, error) {
+		return []interface{}{}, nil
+	}
+	srv.Handle(http.MethodGet, "/aws/emr/mrScaler", empty)
+	srv.Handle(http.MethodGet, "/aws/ec2/managedInstance", empty)
+	srv.Handle(http.MethodGet, "/mcs/kubernetes/cluster/{clusterIdentifier}/costs", empty)
+
+	var userAgent atomic.Value
+	srv.AddHook(func(req *http.Request) error {
+		userAgent.Store(req.UserAgent())
+		return nil
+	})
+
+	setupEnv(t)
+	sess, err := session.NewSession(spotinst.DefaultConfig().
+		WithBaseURL("http://127.0.0.1:1").
+		WithRetryPolicy(spotinst.NoRetryPolicy()).
+		WithCredentials(credentials.NewStaticCredentials("invalid", "act-invalid")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, call := range constructors {
+		t.Run(name, func(t *testing.T) {
+			var logged int32
+			cfg := srv.Config().
+				WithUserAgent(testUserAgent).
+				WithLogger(log.LoggerFunc(func(string, ...interface{}) {
+					atomic.AddInt32(&logged, 1)
+				}))
+
+			if err := call(context.Background(), sess, cfg); err != nil {
+				t.Fatalf("expect no error, got: %v", err)
+			}
+			assert.True(t, strings.HasPrefix(userAgent.Load().(string), testUserAgent))
+			assert.NotZero(t, atomic.LoadInt32(&logged), "expected the logger to be used")
+		})
+	}
+}
+
+// setupEnv isolates the session from the shared config file and the
+// environment of the developer.
+func setupEnv(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv(session.EnvVarConfigFile, filepath.Join(dir, "config"))
+	t.Setenv(session.EnvVarProfile, "")
+	t.Setenv(session.EnvVarBaseURL, "")
+	t.Setenv(session.EnvVarDebug, "")
+}
+
+// TestConstructorSessionConfig verifies that constructors fall back to the
+// session's configuration when no override is given.
+func TestConstructorSessionConfig(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	empty := func(*http.Request, []byte) ([]interface{}, error) {
+		return []interface{}{}, nil
+	}
+	srv.Handle(http.MethodGet, "/aws/emr/mrScaler", empty)
+	srv.Handle(http.MethodGet, "/aws/ec2/managedInstance", empty)
+	srv.Handle(http.MethodGet, "/mcs/kubernetes/cluster/{clusterIdentifier}/costs", empty)
+
+	sess := srv.Session()
+	for name, call := range constructors {
+		t.Run(name, func(t *testing.T) {
+			if err := call(context.Background(), sess, nil); err != nil {
+				t.Fatalf("expect no error, got: %v", err)
+			}
+		})
+	}
+}
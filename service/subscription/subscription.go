@@ -35,6 +35,10 @@ type Subscription struct {
 	// null. It is an error if a field in this list has a non-empty value.
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
+
+	// unknownFields holds the JSON properties that are not modeled by the
+	// SDK, so they are sent back as is.
+	unknownFields jsonutil.UnknownFields
 }
 
 type ListSubscriptionsInput struct{}
@@ -184,10 +188,13 @@ func (s *ServiceOp) Update(ctx context.Context, input *UpdateSubscriptionInput)
 	}
 
 	// We do not need the ID anymore so let's drop it.
-	input.Subscription.ID = nil
+	in := *input
+	subscription := *input.Subscription
+	subscription.ID = nil
+	in.Subscription = &subscription
 
 	r := client.NewRequest(http.MethodPut, path)
-	r.Obj = input
+	r.Obj = &in
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -231,7 +238,12 @@ func (s *ServiceOp) Delete(ctx context.Context, input *DeleteSubscriptionInput)
 func (o Subscription) MarshalJSON() ([]byte, error) {
 	type noMethod Subscription
 	raw := noMethod(o)
-	return jsonutil.MarshalJSON(raw, o.forceSendFields, o.nullFields)
+	return jsonutil.MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *Subscription) UnmarshalJSON(b []byte) error {
+	type noMethod Subscription
+	return jsonutil.UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
 }
 
 func (o *Subscription) SetId(v *string) *Subscription {
@@ -0,0 +1,69 @@
+// Package audit records the mutating calls made by the SDK, e.g. to keep a
+// compliance trail of the changes automation makes to Elastigroups, Ocean
+// clusters or Multai balancers:
+//
+//	sink, err := audit.NewFileSink("/var/log/spotinst/audit.jsonl")
+//	if err != nil {
+//		return err
+//	}
+//	defer sink.Close()
+//
+//	sess := session.New(spotinst.DefaultConfig().WithAuditSink(sink))
+package audit
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// A Record describes a mutating call, i.e. any request other than GET, HEAD
+// or OPTIONS.
+type Record struct {
+	// The time the call was made.
+	Time time.Time `json:"time"`
+
+	// The account the call operated on, if any.
+	Account string `json:"account,omitempty"`
+
+	// The HTTP method and path of the request, e.g. "PUT" and
+	// "/aws/ec2/group/sig-12345678".
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// The ID of the resource the call operated on, taken from the path, or
+	// from the response for resources being created. Empty if unknown.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// The JSON body of the request, with known secret fields redacted (see
+	// package redact).
+	RequestBody json.RawMessage `json:"requestBody,omitempty"`
+
+	// The HTTP status code of the response, or 0 if none was received.
+	StatusCode int `json:"statusCode"`
+
+	// The ID the API assigned to the request, if any.
+	RequestID string `json:"requestId,omitempty"`
+
+	// The duration of the call, including retries.
+	Duration time.Duration `json:"duration"`
+
+	// The error the call failed with before a response was received, e.g. a
+	// network error.
+	Error string `json:"error,omitempty"`
+}
+
+// A Sink receives audit records. Sinks must be safe to use across multiple
+// goroutines.
+type Sink interface {
+	Write(record *Record) error
+}
+
+// The SinkFunc type is an adapter to allow the use of ordinary functions as
+// Sink. If f is a function with the appropriate signature, SinkFunc(f) is a
+// Sink that calls f.
+type SinkFunc func(record *Record) error
+
+// Write calls f(record).
+func (f SinkFunc) Write(record *Record) error {
+	return f(record)
+}
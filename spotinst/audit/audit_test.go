@@ -0,0 +1,93 @@
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/audit"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSink(t *testing.T) {
+	srv := spotinsttest.NewServer()
+	defer srv.Close()
+
+	filename := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	sink, err := audit.NewFileSink(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	svc := aws.New(srv.Session(), new(spotinst.Config).WithAuditSink(sink))
+
+	group := new(aws.Group)
+	group.SetName(spotinst.String("foo"))
+	group.SetIntegration(new(aws.Integration).SetRancher(
+		new(aws.RancherIntegration).SetSecretKey(spotinst.String("s3cr3t-value"))))
+
+	created, err := svc.Create(ctx, &aws.CreateGroupInput{Group: group})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := spotinst.StringValue(created.Group.ID)
+
+	// Reads are not recorded.
+	if _, err := svc.Read(ctx, &aws.ReadGroupInput{GroupID: spotinst.String(id)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := svc.Delete(ctx, &aws.DeleteGroupInput{GroupID: spotinst.String(id)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Delete(ctx, &aws.DeleteGroupInput{GroupID: spotinst.String(id)}); err == nil {
+		t.Fatal("expect error, got nil")
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var records []*audit.Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		record := new(audit.Record)
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+
+	if !assert.Len(t, records, 3) {
+		return
+	}
+
+	create := records[0]
+	assert.Equal(t, "POST", create.Method)
+	assert.Equal(t, "/aws/ec2/group", create.Path)
+	assert.Equal(t, spotinsttest.Account, create.Account)
+	assert.Equal(t, id, create.ResourceID)
+	assert.Equal(t, 200, create.StatusCode)
+	assert.NotEmpty(t, create.RequestID)
+	assert.False(t, create.Time.IsZero())
+	assert.Contains(t, string(create.RequestBody), `"name":"foo"`)
+	assert.NotContains(t, string(create.RequestBody), "s3cr3t-value")
+
+	assert.Equal(t, "DELETE", records[1].Method)
+	assert.Equal(t, id, records[1].ResourceID)
+	assert.Equal(t, 200, records[1].StatusCode)
+	assert.Equal(t, 404, records[2].StatusCode)
+}
@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileSink is a Sink writing records to a file in the JSON Lines format, i.e.
+// one JSON object per line. Records are appended to the file, which is synced
+// after every write so that no record is lost if the process crashes.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewFileSink returns a FileSink appending records to the given file, which is
+// created, along with its directory, if needed.
+func NewFileSink(filename string) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{
+		file: f,
+		enc:  json.NewEncoder(f),
+	}, nil
+}
+
+// Write appends the record to the file.
+func (s *FileSink) Write(record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.enc.Encode(record); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/audit"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/redact"
+)
+
+// resourceIDRegexp matches the IDs of resources in request paths, e.g.
+// "sig-12345678", "o-12345678" or "lb-12345678".
+var resourceIDRegexp = regexp.MustCompile(`^[a-z]+-[0-9a-z]+$`)
+
+// AuditMiddleware returns a middleware that writes a record of every mutating
+// request to the given sink, once the request completes, whether it succeeded
+// or not. Retries are part of the same record. Failures to write records are
+// logged to the given logger, if any, and do not fail requests, as the changes
+// they describe are already made.
+func AuditMiddleware(sink audit.Sink, logger log.Logger) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			if !isMutating(req) {
+				return next.Do(req)
+			}
+
+			start := time.Now()
+			record := &audit.Record{
+				Time:       start.UTC(),
+				Account:    req.URL.Query().Get("accountId"),
+				Method:     req.Method,
+				Path:       req.URL.Path,
+				ResourceID: resourceIDFromPath(req.URL.Path),
+			}
+			if body, err := peekRequestBody(req); err == nil && len(body) > 0 {
+				record.RequestBody = auditBody(body)
+			}
+
+			resp, err := next.Do(req)
+			record.Duration = time.Since(start)
+
+			if err != nil {
+				record.Error = err.Error()
+			} else {
+				record.StatusCode = resp.StatusCode
+				if body, err := peekResponseBody(resp); err == nil {
+					readAuditResponse(record, body)
+				}
+			}
+
+			if werr := sink.Write(record); werr != nil {
+				if leveled := log.Leveled(logger); leveled != nil {
+					leveled.Error("SPOTINST: Failed to write audit record",
+						"method", record.Method,
+						"path", record.Path,
+						"error", werr)
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// resourceIDFromPath returns the first resource ID found in the given path,
+// e.g. "sig-12345678" for "/aws/ec2/group/sig-12345678/roll/sbgd-1234".
+func resourceIDFromPath(path string) string {
+	for _, segment := range strings.Split(path, "/") {
+		if resourceIDRegexp.MatchString(segment) {
+			return segment
+		}
+	}
+	return ""
+}
+
+// auditBody returns the given request body, redacted, as a JSON value. Bodies
+// that are not JSON are recorded as a JSON string.
+func auditBody(body []byte) json.RawMessage {
+	body = redact.JSON(body)
+	if json.Valid(body) {
+		return body
+	}
+
+	b, _ := json.Marshal(string(body))
+	return b
+}
+
+// readAuditResponse sets the request ID of the record, and its resource ID if
+// unknown (e.g. for resources being created), from the given response body.
+func readAuditResponse(record *audit.Record, body []byte) {
+	var out Response
+	if err := json.Unmarshal(body, &out); err != nil {
+		return
+	}
+
+	record.RequestID = out.Request.ID
+
+	if record.ResourceID == "" && len(out.Response.Items) > 0 {
+		var item struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(out.Response.Items[0], &item); err == nil {
+			record.ResourceID = item.ID
+		}
+	}
+}
@@ -3,15 +3,17 @@ package client
 import (
 	"context"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
+	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 )
 
 // Client provides a client to the API.
 type Client struct {
-	config *spotinst.Config
+	config      *spotinst.Config
+	middlewares []spotinst.Middleware
+	handler     spotinst.Handler
 }
 
 // New returns a new client.
@@ -19,7 +21,9 @@ func New(cfg *spotinst.Config) *Client {
 	if cfg == nil {
 		cfg = spotinst.DefaultConfig()
 	}
-	return &Client{cfg}
+	c := &Client{config: cfg}
+	c.Use(cfg.Middlewares...)
+	return c
 }
 
 // NewRequest is used to create a new request.
@@ -34,48 +38,109 @@ func NewRequest(method, path string) *Request {
 	}
 }
 
-// Do runs a request with our client.
+// Use appends middlewares to the client's chain. The chain is built as
+// follows, from the outermost middleware to the innermost one:
+//
+//	ReadOnlyMiddleware -> DryRunMiddleware -> AuditMiddleware ->
+//	RetryMiddleware -> AuthRefreshMiddleware -> RateLimitMiddleware ->
+//	mws... -> LoggingMiddleware
+//
+// ReadOnlyMiddleware, DryRunMiddleware and AuditMiddleware are only used if
+// enabled by the client's config.
+//
+// Use is not safe to call concurrently with Do and should be called before the
+// client is used.
+func (c *Client) Use(mws ...spotinst.Middleware) {
+	c.middlewares = append(c.middlewares, mws...)
+
+	chain := make([]spotinst.Middleware, 0, len(c.middlewares)+7)
+	chain = append(chain,
+		c.lazy(func(cfg *spotinst.Config) spotinst.Middleware {
+			if !spotinst.BoolValue(cfg.ReadOnly) {
+				return passThrough
+			}
+			return ReadOnlyMiddleware()
+		}),
+		c.lazy(func(cfg *spotinst.Config) spotinst.Middleware {
+			if !spotinst.BoolValue(cfg.DryRun) {
+				return passThrough
+			}
+			return DryRunMiddleware(cfg.Logger)
+		}),
+		c.lazy(func(cfg *spotinst.Config) spotinst.Middleware {
+			if cfg.AuditSink == nil {
+				return passThrough
+			}
+			return AuditMiddleware(cfg.AuditSink, cfg.Logger)
+		}),
+		c.lazy(func(cfg *spotinst.Config) spotinst.Middleware {
+			return RetryMiddleware(cfg.RetryPolicy, cfg.Logger)
+		}),
+		c.lazy(func(cfg *spotinst.Config) spotinst.Middleware {
+			return AuthRefreshMiddleware(cfg.Credentials)
+		}),
+		c.lazy(func(cfg *spotinst.Config) spotinst.Middleware {
+			return RateLimitMiddleware(cfg.RateLimiter)
+		}))
+	chain = append(chain, c.middlewares...)
+	chain = append(chain,
+		c.lazy(func(cfg *spotinst.Config) spotinst.Middleware {
+			return LoggingMiddleware(cfg.Logger)
+		}))
+
+	c.handler = spotinst.Chain(c.transport(), chain...)
+}
+
+// Do runs a request with our client. The request is sent through the client's
+// middleware chain; see Use for details. The call options carried by ctx, if
+// any, are applied to the request; see WithCallOptions.
 func (c *Client) Do(ctx context.Context, r *Request) (*http.Response, error) {
+	opts := callOptionsFrom(ctx)
+	ctx, cancel := opts.withTimeout(ctx)
+	ctx, attempts := withAttempts(ctx)
+
 	req, err := r.toHTTP(ctx, c.config)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
-	c.logRequest(req)
-	resp, err := c.config.HTTPClient.Do(req)
-	c.logResponse(resp)
-	return resp, err
-}
 
-func (c *Client) logf(format string, args ...interface{}) {
-	if c.config.Logger != nil {
-		c.config.Logger.Printf(format, args...)
+	start := time.Now()
+	resp, err := c.handler.Do(req)
+	if opts.metadata != nil {
+		setMetadata(opts.metadata, resp, time.Since(start), *attempts)
+	}
+	if err != nil {
+		cancel()
+		return nil, err
 	}
+
+	// The context must outlive Do, until the response body is read.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
 }
 
-const logReqMsg = `SPOTINST: Request "%s %s" details:
----[ REQUEST ]---------------------------------------
-%s
------------------------------------------------------`
-
-func (c *Client) logRequest(req *http.Request) {
-	if c.config.Logger != nil && req != nil {
-		out, err := httputil.DumpRequestOut(req, true)
-		if err == nil {
-			c.logf(logReqMsg, req.Method, req.URL, string(out))
-		}
+// lazy returns a middleware built from the client's config at request time,
+// so changes made to the config after the client was created are honored.
+func (c *Client) lazy(fn func(cfg *spotinst.Config) spotinst.Middleware) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			return fn(c.config)(next).Do(req)
+		})
 	}
 }
 
-const logRespMsg = `SPOTINST: Response "%s %s" details:
----[ RESPONSE ]----------------------------------------
-%s
--------------------------------------------------------`
-
-func (c *Client) logResponse(resp *http.Response) {
-	if c.config.Logger != nil && resp != nil {
-		out, err := httputil.DumpResponse(resp, true)
-		if err == nil {
-			c.logf(logRespMsg, resp.Request.Method, resp.Request.URL, string(out))
-		}
-	}
+// passThrough is a middleware that does nothing.
+func passThrough(next spotinst.Handler) spotinst.Handler {
+	return next
+}
+
+// transport returns the innermost handler, which sends requests using the
+// configured HTTP client.
+func (c *Client) transport() spotinst.Handler {
+	return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+		countAttempt(req.Context())
+		return c.config.HTTPClient.Do(req)
+	})
 }
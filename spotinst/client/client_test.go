@@ -0,0 +1,504 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConfig(url string) *spotinst.Config {
+	return spotinst.DefaultConfig().
+		WithBaseURL(url).
+		WithCredentials(credentials.NewStaticCredentials("token", "act-12345")).
+		WithRetryPolicy(&spotinst.RetryPolicy{
+			MaxAttempts:          3,
+			BaseDelay:            time.Millisecond,
+			MaxDelay:             10 * time.Millisecond,
+			RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+		})
+}
+
+func TestDoRetry(t *testing.T) {
+	cases := map[string]struct {
+		Method             string
+		NotIdempotent      bool
+		RetryNonIdempotent bool
+		Statuses           []int
+		ExpectedCode       int
+		ExpectedHits       int32
+	}{
+		"success": {
+			Statuses:     []int{200},
+			ExpectedCode: 200,
+			ExpectedHits: 1,
+		},
+		"retryable_then_success": {
+			Statuses:     []int{503, 429, 200},
+			ExpectedCode: 200,
+			ExpectedHits: 3,
+		},
+		"retryable_exhausted": {
+			Statuses:     []int{503, 503, 503, 503},
+			ExpectedCode: 503,
+			ExpectedHits: 3,
+		},
+		"not_retryable": {
+			Statuses:     []int{400, 200},
+			ExpectedCode: 400,
+			ExpectedHits: 1,
+		},
+		"not_idempotent": {
+			Method:       http.MethodPost,
+			Statuses:     []int{503, 200},
+			ExpectedCode: 503,
+			ExpectedHits: 1,
+		},
+		"not_idempotent_flagged": {
+			NotIdempotent: true,
+			Statuses:      []int{503, 200},
+			ExpectedCode:  503,
+			ExpectedHits:  1,
+		},
+		"not_idempotent_rate_limited": {
+			Method:       http.MethodPost,
+			Statuses:     []int{429, 200},
+			ExpectedCode: 200,
+			ExpectedHits: 2,
+		},
+		"not_idempotent_opt_in": {
+			Method:             http.MethodPost,
+			RetryNonIdempotent: true,
+			Statuses:           []int{503, 200},
+			ExpectedCode:       200,
+			ExpectedHits:       2,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var hits int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&hits, 1)
+				body, _ := ioutil.ReadAll(r.Body)
+				assert.JSONEq(t, `{"name":"foo"}`, string(body))
+				w.WriteHeader(c.Statuses[n-1])
+			}))
+			defer ts.Close()
+
+			method := c.Method
+			if method == "" {
+				method = http.MethodPut
+			}
+			req := NewRequest(method, "/aws/ec2/group/sig-1")
+			req.Obj = map[string]string{"name": "foo"}
+			req.NotIdempotent = c.NotIdempotent
+
+			cfg := testConfig(ts.URL)
+			cfg.RetryPolicy.RetryNonIdempotent = c.RetryNonIdempotent
+
+			resp, err := New(cfg).Do(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			assert.Equal(t, c.ExpectedCode, resp.StatusCode)
+			assert.Equal(t, c.ExpectedHits, atomic.LoadInt32(&hits))
+		})
+	}
+}
+
+func TestDoRetryAfter(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	cfg := testConfig(ts.URL)
+	cfg.RetryPolicy.MaxDelay = 0
+
+	start := time.Now()
+	resp, err := New(cfg).Do(context.Background(), NewRequest(http.MethodGet, "/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, time.Since(start) >= time.Second)
+}
+
+func TestDoRetryContextCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	cfg := testConfig(ts.URL)
+	cfg.RetryPolicy.MaxDelay = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := New(cfg).Do(ctx, NewRequest(http.MethodGet, "/"))
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		assert.Equal(t, "abc", r.Header.Get("X-Trace-Id"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	var order []string
+	record := func(name string) spotinst.Middleware {
+		return func(next spotinst.Handler) spotinst.Handler {
+			return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.Do(req)
+			})
+		}
+	}
+
+	cfg := testConfig(ts.URL).WithMiddleware(record("config"))
+	c := New(cfg)
+	c.Use(record("use"), HeaderMiddleware(http.Header{"X-Trace-Id": {"abc"}}))
+
+	resp, err := c.Do(context.Background(), NewRequest(http.MethodGet, "/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	assert.Equal(t, []string{"config", "use"}, order)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestErrorInjectionMiddleware(t *testing.T) {
+	injected := errors.New("boom")
+
+	c := New(testConfig("http://localhost:0"))
+	c.Use(ErrorInjectionMiddleware(func(req *http.Request) error {
+		return injected
+	}))
+
+	_, err := c.Do(context.Background(), NewRequest(http.MethodGet, "/"))
+	assert.True(t, errors.Is(err, injected))
+}
+
+func TestLoggingRedaction(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"items":[{"chef":{"pemKey":"response-secret"}}]}}`))
+	}))
+	defer ts.Close()
+
+	var std, leveled strings.Builder
+	loggers := map[string]log.Logger{
+		"std": log.LoggerFunc(func(format string, args ...interface{}) {
+			fmt.Fprintf(&std, format, args...)
+		}),
+		"leveled": log.NewStdLogger(log.LoggerFunc(func(format string, args ...interface{}) {
+			fmt.Fprintf(&leveled, format, args...)
+		}), log.LevelDebug),
+	}
+
+	for name, logger := range loggers {
+		req := NewRequest(http.MethodPost, "/aws/ec2/group")
+		req.Obj = map[string]interface{}{
+			"rancher": map[string]string{"secretKey": "request-secret"},
+		}
+
+		resp, err := New(testConfig(ts.URL).WithLogger(logger)).Do(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.Contains(t, string(body), "response-secret", name)
+	}
+
+	for name, out := range map[string]string{"std": std.String(), "leveled": leveled.String()} {
+		assert.Contains(t, out, "[REDACTED]", name)
+		assert.NotContains(t, out, "token", name)
+		assert.NotContains(t, out, "request-secret", name)
+		assert.NotContains(t, out, "response-secret", name)
+	}
+	assert.Contains(t, leveled.String(), "[DEBUG] SPOTINST: Response")
+}
+
+// countingReader counts the reads of its underlying reader.
+type countingReader struct {
+	*strings.Reader
+	reads int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	r.reads++
+	return r.Reader.Read(p)
+}
+
+func (r *countingReader) Close() error { return nil }
+
+func TestLoggingLevel(t *testing.T) {
+	for _, level := range []log.Level{log.LevelInfo, log.LevelDebug} {
+		var out strings.Builder
+		logger := log.NewStdLogger(log.LoggerFunc(func(format string, args ...interface{}) {
+			fmt.Fprintf(&out, format, args...)
+		}), level)
+
+		reqBody := &countingReader{Reader: strings.NewReader(`{"name":"foo"}`)}
+		respBody := &countingReader{Reader: strings.NewReader(`{"response":{}}`)}
+
+		handler := LoggingMiddleware(logger)(spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: respBody, Request: req}, nil
+		}))
+
+		req, _ := http.NewRequest(http.MethodPost, "http://localhost/aws/ec2/group", reqBody)
+		req.GetBody = nil
+		if _, err := handler.Do(req); err != nil {
+			t.Fatal(err)
+		}
+
+		// The bodies are only dumped if debug logging is enabled.
+		debug := level == log.LevelDebug
+		assert.Equal(t, debug, reqBody.reads > 0, "level %s", level)
+		assert.Equal(t, debug, respBody.reads > 0, "level %s", level)
+		assert.Equal(t, debug, strings.Contains(out.String(), `{\"name\":\"foo\"}`), "level %s", level)
+	}
+}
+
+func TestCallOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if d, err := time.ParseDuration(r.URL.Query().Get("sleep")); err == nil {
+			select {
+			case <-time.After(d):
+			case <-r.Context().Done():
+			}
+		}
+		fmt.Fprintf(w, "%s %s", r.URL.Query().Get("accountId"), r.Header.Get("X-Trace-Id"))
+	}))
+	defer ts.Close()
+
+	c := New(testConfig(ts.URL).WithRetryPolicy(spotinst.NoRetryPolicy()))
+	do := func(ctx context.Context, sleep time.Duration) (string, error) {
+		req := NewRequest(http.MethodGet, "/aws/ec2/group")
+		req.Params.Set("sleep", sleep.String())
+		resp, err := c.Do(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	// Defaults.
+	body, err := do(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "act-12345 ", body)
+
+	// Overrides, used concurrently.
+	done := make(chan struct{})
+	for _, account := range []string{"act-1", "act-2"} {
+		go func(account string) {
+			defer func() { done <- struct{}{} }()
+			ctx := WithCallOptions(context.Background(), WithAccount(account))
+			ctx = WithCallOptions(ctx, WithHeader("X-Trace-Id", "trace-"+account))
+			body, err := do(ctx, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, account+" trace-"+account, body)
+		}(account)
+	}
+	<-done
+	<-done
+
+	// Timeout.
+	ctx := WithCallOptions(context.Background(), WithTimeout(10*time.Millisecond))
+	_, err = do(ctx, time.Second)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "expected deadline exceeded, got %v", err)
+	_, err = do(ctx, 0)
+	assert.NoError(t, err)
+}
+
+func TestMetadata(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Trace-Id", "trace")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"request":{"id":"req-1"},"response":{"items":[]}}`)
+	}))
+	defer ts.Close()
+
+	c := New(testConfig(ts.URL))
+
+	var md Metadata
+	ctx := WithCallOptions(context.Background(), WithMetadata(&md))
+	resp, err := RequireOK(c.Do(ctx, NewRequest(http.MethodGet, "/aws/ec2/group")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.Equal(t, "req-1", md.RequestID)
+	assert.Equal(t, http.StatusOK, md.StatusCode)
+	assert.Equal(t, "trace", md.Header.Get("X-Trace-Id"))
+	assert.Equal(t, 1, md.RetryCount)
+	assert.NotZero(t, md.Latency)
+	assert.Contains(t, string(body), "req-1", "expected the body to be left intact")
+
+	// Failed calls.
+	c = New(testConfig("http://127.0.0.1:1").WithRetryPolicy(spotinst.NoRetryPolicy()))
+	_, err = c.Do(ctx, NewRequest(http.MethodGet, "/aws/ec2/group"))
+	assert.Error(t, err)
+	assert.Equal(t, Metadata{Latency: md.Latency}, md)
+}
+
+func TestSafetyModes(t *testing.T) {
+	var sent int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+		fmt.Fprint(w, `{"request":{"id":"req-1"},"response":{"items":[]}}`)
+	}))
+	defer ts.Close()
+
+	var logged []string
+	logger := log.LoggerFunc(func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	})
+
+	newRequest := func(method string) *Request {
+		req := NewRequest(method, "/aws/ec2/group")
+		req.Obj = map[string]interface{}{
+			"group": map[string]interface{}{"name": "foo", "password": "secret"},
+		}
+		return req
+	}
+
+	// Dry run.
+	c := New(testConfig(ts.URL).WithLogger(logger).WithDryRun(true))
+
+	resp, err := RequireOK(c.Do(context.Background(), newRequest(http.MethodPost)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&sent))
+	assert.JSONEq(t, `{"request":{"id":"dry-run"},"response":{"errors":null,"items":[{"name":"foo","password":"secret"}]}}`, string(body))
+	if assert.Len(t, logged, 1) {
+		assert.Contains(t, logged[0], "foo")
+		assert.NotContains(t, logged[0], "secret")
+	}
+
+	resp, err = RequireOK(c.Do(context.Background(), NewRequest(http.MethodGet, "/aws/ec2/group")))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sent))
+
+	// Read only, which takes precedence.
+	c = New(testConfig(ts.URL).WithDryRun(true).WithReadOnly(true))
+
+	_, err = c.Do(context.Background(), newRequest(http.MethodDelete))
+	assert.True(t, errors.Is(err, ErrReadOnly), "expected read only, got %v", err)
+	var roErr *ReadOnlyError
+	if assert.True(t, errors.As(err, &roErr)) {
+		assert.Equal(t, http.MethodDelete, roErr.Method)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sent))
+
+	resp, err = c.Do(context.Background(), NewRequest(http.MethodGet, "/aws/ec2/group"))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+}
+
+type rotatingProvider struct {
+	tokens []string
+	calls  int32
+}
+
+func (p *rotatingProvider) Retrieve() (credentials.Value, error) {
+	n := atomic.AddInt32(&p.calls, 1)
+	if int(n) > len(p.tokens) {
+		n = int32(len(p.tokens))
+	}
+	return credentials.Value{Token: p.tokens[n-1]}, nil
+}
+func (p *rotatingProvider) String() string { return "rotating" }
+
+func TestAuthRefresh(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"foo"}`, string(body))
+		if r.Header.Get("Authorization") != "Bearer token2" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer ts.Close()
+
+	cases := map[string]struct {
+		Tokens       []string
+		ExpectedCode int
+		ExpectedHits int32
+	}{
+		"rotated": {
+			Tokens:       []string{"token1", "token2"},
+			ExpectedCode: http.StatusOK,
+			ExpectedHits: 2,
+		},
+		"not_rotated": {
+			Tokens:       []string{"token1"},
+			ExpectedCode: http.StatusUnauthorized,
+			ExpectedHits: 1,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			atomic.StoreInt32(&hits, 0)
+			creds := credentials.NewCredentials(&rotatingProvider{tokens: c.Tokens})
+
+			req := NewRequest(http.MethodPost, "/aws/ec2/group")
+			req.Obj = map[string]string{"name": "foo"}
+
+			resp, err := New(testConfig(ts.URL).WithCredentials(creds)).Do(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			assert.Equal(t, c.ExpectedCode, resp.StatusCode)
+			assert.Equal(t, c.ExpectedHits, atomic.LoadInt32(&hits))
+		})
+	}
+}
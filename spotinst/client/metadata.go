@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Metadata describes the HTTP exchange of an API call.
+type Metadata struct {
+	// The ID the API assigned to the request. Spotinst support asks for it
+	// when investigating requests.
+	RequestID string
+
+	// The HTTP status code of the last response, or 0 if none was received.
+	StatusCode int
+
+	// The headers of the last response.
+	Header http.Header
+
+	// The duration of the call, including retries.
+	Latency time.Duration
+
+	// The number of times the request was retried.
+	RetryCount int
+}
+
+// WithMetadata fills md with the metadata of the call once it completes,
+// whether it succeeded or not. Output types are left unchanged; to get the
+// request ID of a call, set the option on its context:
+//
+//	var md client.Metadata
+//	out, err := svc.Read(client.WithCallOptions(ctx, client.WithMetadata(&md)), input)
+//	log.Printf("request: %s, status: %d", md.RequestID, md.StatusCode)
+//
+// md holds the metadata of the last call made with the context, and must not
+// be shared by concurrent calls.
+func WithMetadata(md *Metadata) CallOption {
+	return func(o *callOptions) {
+		o.metadata = md
+	}
+}
+
+// attemptsKey is the context key of the counter of the attempts of a request.
+type attemptsKey struct{}
+
+// withAttempts returns a copy of ctx carrying a counter of the attempts made
+// to send a request, which is incremented by the client's transport.
+func withAttempts(ctx context.Context) (context.Context, *int) {
+	attempts := new(int)
+	return context.WithValue(ctx, attemptsKey{}, attempts), attempts
+}
+
+// countAttempt increments the counter of attempts carried by ctx, if any.
+func countAttempt(ctx context.Context) {
+	if attempts, ok := ctx.Value(attemptsKey{}).(*int); ok {
+		*attempts++
+	}
+}
+
+// setMetadata fills md from the last response of a call. The response's body
+// is left intact.
+func setMetadata(md *Metadata, resp *http.Response, latency time.Duration, attempts int) {
+	*md = Metadata{Latency: latency}
+	if attempts > 1 {
+		md.RetryCount = attempts - 1
+	}
+	if resp == nil {
+		return
+	}
+
+	md.StatusCode = resp.StatusCode
+	md.Header = resp.Header.Clone()
+
+	if body, err := peekResponseBody(resp); err == nil && len(body) > 0 {
+		var out Response
+		if err := json.Unmarshal(body, &out); err == nil {
+			md.RequestID = out.Request.ID
+		}
+	}
+}
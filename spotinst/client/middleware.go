@@ -0,0 +1,317 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/ratelimit"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/redact"
+)
+
+// RetryMiddleware returns a middleware that retries requests that failed with
+// a transient error according to the given policy. Retries honor the
+// Retry-After header, rewind the request body, and stop as soon as the
+// request's context is done. A nil policy disables retries.
+func RetryMiddleware(policy *spotinst.RetryPolicy, logger log.Logger) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			for attempt := 1; ; attempt++ {
+				resp, err := next.Do(req)
+				if !shouldRetry(ctx, policy, attempt, req, resp, err) {
+					return resp, err
+				}
+
+				delay := retryDelay(policy, attempt, resp)
+				drainBody(resp)
+				logRetry(logger, req, delay, attempt+1, policy.MaxAttempts, resp, err)
+
+				if err := sleep(ctx, delay); err != nil {
+					return nil, err
+				}
+				if req, err = rewindRequest(req); err != nil {
+					return nil, err
+				}
+			}
+		})
+	}
+}
+
+func logRetry(logger log.Logger, req *http.Request, delay time.Duration,
+	attempt, maxAttempts int, resp *http.Response, err error) {
+	if leveled, ok := logger.(log.LeveledLogger); ok {
+		kv := []interface{}{
+			"method", req.Method,
+			"url", req.URL.String(),
+			"delay", delay,
+			"attempt", attempt,
+			"maxAttempts", maxAttempts,
+		}
+		if err != nil {
+			kv = append(kv, "error", err)
+		} else {
+			kv = append(kv, "status", resp.StatusCode)
+		}
+		leveled.Warn("SPOTINST: Retrying request", kv...)
+		return
+	}
+
+	logf(logger, "SPOTINST: Retrying request \"%s %s\" in %v (attempt %d/%d)",
+		req.Method, req.URL, delay, attempt, maxAttempts)
+}
+
+// AuthRefreshMiddleware returns a middleware that handles 401 Unauthorized
+// responses by refreshing the given credentials and, if this yields a new
+// token, retrying the request once with it. This allows tokens rotated
+// (e.g. by a secret manager) to be picked up by long-running processes.
+func AuthRefreshMiddleware(creds *credentials.Credentials) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			if err != nil || resp.StatusCode != http.StatusUnauthorized || creds == nil {
+				return resp, err
+			}
+
+			creds.Refresh()
+			value, err := creds.Get()
+			if err != nil || value.Token == "" ||
+				req.Header.Get("Authorization") == "Bearer "+value.Token {
+				// Nothing new to retry with.
+				return resp, nil
+			}
+
+			retry, err := rewindRequest(req)
+			if err != nil {
+				return resp, nil
+			}
+			drainBody(resp)
+
+			retry.Header.Set("Authorization", "Bearer "+value.Token)
+			return next.Do(retry)
+		})
+	}
+}
+
+// RateLimitMiddleware returns a middleware that waits for the given limiter
+// before sending requests. A nil limiter does not limit requests.
+func RateLimitMiddleware(limiter *ratelimit.Limiter) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			if err := limiter.Wait(req.Context(), req.URL.Path); err != nil {
+				return nil, err
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// LoggingMiddleware returns a middleware that logs requests and responses to
+// the given logger. Tokens and known secret fields (see package redact) are
+// redacted from the logged headers and bodies. A nil logger disables logging.
+//
+// If the logger implements log.LeveledLogger, requests and responses are
+// logged at debug level with structured fields, and failures at error level.
+func LoggingMiddleware(logger log.Logger) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			if logger == nil {
+				return next.Do(req)
+			}
+
+			leveled, ok := logger.(log.LeveledLogger)
+			if !ok {
+				logRequest(logger, req)
+				resp, err := next.Do(req)
+				logResponse(logger, resp)
+				return resp, err
+			}
+
+			leveled.Debug("SPOTINST: Request",
+				"method", req.Method,
+				"url", req.URL.String(),
+				"details", lazyDump(func() string { return dumpRequest(req) }))
+
+			start := time.Now()
+			resp, err := next.Do(req)
+			duration := time.Since(start)
+
+			if err != nil {
+				leveled.Error("SPOTINST: Request failed",
+					"method", req.Method,
+					"url", req.URL.String(),
+					"duration", duration,
+					"error", err)
+				return resp, err
+			}
+
+			leveled.Debug("SPOTINST: Response",
+				"method", req.Method,
+				"url", req.URL.String(),
+				"status", resp.StatusCode,
+				"duration", duration,
+				"details", lazyDump(func() string { return dumpResponse(resp) }))
+
+			return resp, err
+		})
+	}
+}
+
+// lazyDump is a field value dumping a request or response when formatted, so
+// that leveled loggers only dump them if debug logging is enabled. Loggers
+// format their fields before returning, while the bodies can still be peeked.
+type lazyDump func() string
+
+func (f lazyDump) String() string { return f() }
+
+func logf(logger log.Logger, format string, args ...interface{}) {
+	if logger != nil {
+		logger.Printf(format, args...)
+	}
+}
+
+const logReqMsg = `SPOTINST: Request "%s %s" details:
+---[ REQUEST ]---------------------------------------
+%s
+-----------------------------------------------------`
+
+func logRequest(logger log.Logger, req *http.Request) {
+	if req != nil {
+		logf(logger, logReqMsg, req.Method, req.URL, dumpRequest(req))
+	}
+}
+
+const logRespMsg = `SPOTINST: Response "%s %s" details:
+---[ RESPONSE ]----------------------------------------
+%s
+-------------------------------------------------------`
+
+func logResponse(logger log.Logger, resp *http.Response) {
+	if resp != nil {
+		logf(logger, logRespMsg, resp.Request.Method, resp.Request.URL, dumpResponse(resp))
+	}
+}
+
+// dumpRequest returns the wire representation of req, with sensitive headers
+// and body fields redacted. The request's body is left intact.
+func dumpRequest(req *http.Request) string {
+	body, err := peekRequestBody(req)
+	if err != nil {
+		return err.Error()
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Header = redact.Header(req.Header)
+	if body != nil {
+		body = redact.JSON(body)
+		clone.Body = ioutil.NopCloser(bytes.NewReader(body))
+		clone.ContentLength = int64(len(body))
+	}
+
+	out, err := httputil.DumpRequestOut(clone, true)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(out)
+}
+
+// dumpResponse returns the wire representation of resp, with sensitive headers
+// and body fields redacted. The response's body is left intact.
+func dumpResponse(resp *http.Response) string {
+	body, err := peekResponseBody(resp)
+	if err != nil {
+		return err.Error()
+	}
+
+	clone := *resp
+	clone.Header = redact.Header(resp.Header)
+	if body != nil {
+		body = redact.JSON(body)
+		clone.Body = ioutil.NopCloser(bytes.NewReader(body))
+		clone.ContentLength = int64(len(body))
+	}
+
+	out, err := httputil.DumpResponse(&clone, true)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(out)
+}
+
+// peekRequestBody returns the body of req without consuming it.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	return b, nil
+}
+
+// peekResponseBody returns the body of resp without consuming it.
+func peekResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	return b, nil
+}
+
+// HeaderMiddleware returns a middleware that sets the given headers on every
+// request, e.g. to propagate tracing information.
+func HeaderMiddleware(header http.Header) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			for k, vs := range header {
+				req.Header.Del(k)
+				for _, v := range vs {
+					req.Header.Add(k, v)
+				}
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// ErrorInjectionMiddleware returns a middleware that fails requests for which
+// fn returns a non-nil error, without sending them. It is useful to test how
+// callers handle failures.
+func ErrorInjectionMiddleware(fn func(req *http.Request) error) spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			if err := fn(req); err != nil {
+				return nil, fmt.Errorf("spotinst: injected error: %w", err)
+			}
+			return next.Do(req)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// A CallOption overrides the client's configuration for a single API call.
+// Call options are carried by the context passed to any Service method:
+//
+//	ctx = client.WithCallOptions(ctx,
+//		client.WithAccount("act-12345678"),
+//		client.WithTimeout(30*time.Second))
+//
+//	out, err := svc.Read(ctx, input)
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	account  *string
+	header   http.Header
+	timeout  time.Duration
+	metadata *Metadata
+}
+
+type callOptionsKey struct{}
+
+// WithCallOptions returns a copy of ctx carrying the given call options, in
+// addition to those already carried by ctx. Later options take precedence.
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	o := callOptionsFrom(ctx).clone()
+	for _, opt := range opts {
+		opt(o)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+// WithAccount overrides the account the call operates on. It allows a single
+// client to operate across accounts concurrently.
+func WithAccount(accountID string) CallOption {
+	return func(o *callOptions) {
+		o.account = &accountID
+	}
+}
+
+// WithHeader sets a header on the request, replacing any existing value.
+func WithHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithTimeout bounds the duration of the call, including its retries and the
+// reading of the response body.
+func WithTimeout(d time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = d
+	}
+}
+
+// callOptionsFrom returns the call options carried by ctx, or empty ones.
+func callOptionsFrom(ctx context.Context) *callOptions {
+	if o, ok := ctx.Value(callOptionsKey{}).(*callOptions); ok {
+		return o
+	}
+	return &callOptions{header: make(http.Header)}
+}
+
+func (o *callOptions) clone() *callOptions {
+	c := *o
+	c.header = o.header.Clone()
+	return &c
+}
+
+// withTimeout applies the call's timeout, if any, to ctx.
+func (o *callOptions) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.timeout > 0 {
+		return context.WithTimeout(ctx, o.timeout)
+	}
+	return ctx, func() {}
+}
+
+// cancelOnClose wraps a response body to cancel the call's context once the
+// body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
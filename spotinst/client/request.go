@@ -5,15 +5,23 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/jsonutil"
 )
 
 type Request struct {
 	Obj    interface{}
 	Params url.Values
+
+	// NotIdempotent flags a request whose method is idempotent (e.g. PUT)
+	// but which performs an action that is not, e.g. scaling a group, so
+	// that it is retried as a POST request is.
+	NotIdempotent bool
+
 	url    *url.URL
 	method string
 	body   io.Reader
@@ -34,6 +42,12 @@ func (r *Request) toHTTP(ctx context.Context, cfg *spotinst.Config) (*http.Reque
 		r.Params.Set("accountId", creds.Account)
 	}
 
+	// Apply the call options.
+	opts := callOptionsFrom(ctx)
+	if opts.account != nil {
+		r.Params.Set("accountId", *opts.account)
+	}
+
 	// Encode the query parameters.
 	r.url.RawQuery = r.Params.Encode()
 
@@ -44,10 +58,32 @@ func (r *Request) toHTTP(ctx context.Context, cfg *spotinst.Config) (*http.Reque
 		} else {
 			r.body = b
 		}
+
+		// Only send the fields modeled by the SDK, if asked to.
+		if cfg.PreserveUnknownFields != nil && !*cfg.PreserveUnknownFields {
+			b, err := ioutil.ReadAll(r.body)
+			if err != nil {
+				return nil, err
+			}
+			if b, err = jsonutil.StripUnknownFields(b, r.Obj); err != nil {
+				return nil, err
+			}
+			r.body = bytes.NewReader(b)
+		}
+	}
+
+	// Buffer the body, so it can be rewound when the request is retried.
+	var body io.Reader
+	if r.body != nil {
+		b, err := ioutil.ReadAll(r.body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
 	}
 
 	// Create the HTTP request.
-	req, err := http.NewRequest(r.method, r.url.RequestURI(), r.body)
+	req, err := http.NewRequest(r.method, r.url.RequestURI(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +98,13 @@ func (r *Request) toHTTP(ctx context.Context, cfg *spotinst.Config) (*http.Reque
 	req.Header.Set("Content-Type", cfg.ContentType)
 	req.Header.Add("Accept", cfg.ContentType)
 	req.Header.Add("User-Agent", cfg.UserAgent)
+	for k, vs := range opts.header {
+		req.Header[k] = append([]string(nil), vs...)
+	}
+
+	if r.NotIdempotent {
+		ctx = context.WithValue(ctx, notIdempotentKey{}, true)
+	}
 
 	return req.WithContext(ctx), nil
 }
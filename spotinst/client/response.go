@@ -3,11 +3,13 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 type Response struct {
@@ -35,9 +37,15 @@ type Error struct {
 }
 
 func (e Error) Error() string {
-	msg := fmt.Sprintf("%v %v: %d (request: %q) %v: %v",
-		e.Response.Request.Method, e.Response.Request.URL,
-		e.Response.StatusCode, e.RequestID, e.Code, e.Message)
+	var msg string
+	if e.Response != nil && e.Response.Request != nil {
+		msg = fmt.Sprintf("%v %v: %d (request: %q) %v: %v",
+			e.Response.Request.Method, e.Response.Request.URL,
+			e.Response.StatusCode, e.RequestID, e.Code, e.Message)
+	} else {
+		msg = fmt.Sprintf("%d (request: %q) %v: %v",
+			e.StatusCode(), e.RequestID, e.Code, e.Message)
+	}
 
 	if e.Field != "" {
 		msg = fmt.Sprintf("%s (field: %v)", msg, e.Field)
@@ -46,6 +54,49 @@ func (e Error) Error() string {
 	return msg
 }
 
+// StatusCode returns the HTTP status code of the response that carried the
+// error, or 0 if unknown.
+func (e Error) StatusCode() int {
+	if e.Response == nil {
+		return 0
+	}
+	return e.Response.StatusCode
+}
+
+// Is reports whether the error matches target, which is one of the sentinel
+// errors (e.g. ErrNotFound), based on the API error code, as the API reports
+// missing resources with 400 Bad Request and a code such as
+// GROUP_DOESNT_EXIST, or else on the HTTP status code of the response. It
+// allows errors.Is(err, client.ErrNotFound).
+func (e Error) Is(target error) bool {
+	sentinel, ok := target.(*sentinelError)
+	if !ok {
+		return false
+	}
+	if isNotFoundCode(e.Code) {
+		return sentinel == ErrNotFound
+	}
+	return sentinel.match(e.StatusCode())
+}
+
+// notFoundCodeSuffixes are the suffixes of the API error codes reporting
+// missing resources, e.g. GROUP_DOESNT_EXIST or CLUSTER_DOESNT_EXIST.
+var notFoundCodeSuffixes = []string{"_DOESNT_EXIST", "_DOES_NOT_EXIST", "_NOT_FOUND"}
+
+// isNotFoundCode reports whether the given API error code reports a missing
+// resource, e.g. GROUP_DOESNT_EXIST.
+func isNotFoundCode(code string) bool {
+	if code == "NOT_FOUND" {
+		return true
+	}
+	for _, suffix := range notFoundCodeSuffixes {
+		if strings.HasSuffix(code, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
 type Errors []Error
 
 func (es Errors) Error() string {
@@ -56,6 +107,101 @@ func (es Errors) Error() string {
 	return stack
 }
 
+// Is reports whether any of the errors matches target.
+func (es Errors) Is(target error) bool {
+	for _, e := range es {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error that matches target, and if so, sets target to
+// that error value and returns true. It allows errors.As(err, &client.Error{}).
+func (es Errors) As(target interface{}) bool {
+	for _, e := range es {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sentinel errors classifying API errors by their HTTP status code, or their
+// API error code for ErrNotFound. Use them with errors.Is, e.g.
+// errors.Is(err, client.ErrNotFound).
+var (
+	ErrNotFound     error = newSentinelError("not found", http.StatusNotFound)
+	ErrUnauthorized error = newSentinelError("unauthorized", http.StatusUnauthorized)
+	ErrForbidden    error = newSentinelError("forbidden", http.StatusForbidden)
+	ErrRateLimited  error = newSentinelError("rate limited", http.StatusTooManyRequests)
+	ErrConflict     error = newSentinelError("conflict", http.StatusConflict)
+	ErrValidation   error = newSentinelError("validation failed", http.StatusBadRequest, http.StatusUnprocessableEntity)
+	ErrServerError  error = &sentinelError{
+		msg:   "server error",
+		match: func(code int) bool { return code >= 500 },
+	}
+)
+
+type sentinelError struct {
+	msg   string
+	match func(code int) bool
+}
+
+func newSentinelError(msg string, codes ...int) *sentinelError {
+	return &sentinelError{
+		msg: msg,
+		match: func(code int) bool {
+			for _, c := range codes {
+				if c == code {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+func (e *sentinelError) Error() string {
+	return "spotinst: " + e.msg
+}
+
+// StatusCode returns the HTTP status code carried by err, if err is (or wraps)
+// an API error, or 0 otherwise.
+func StatusCode(err error) int {
+	var e Error
+	if errors.As(err, &e) {
+		return e.StatusCode()
+	}
+	return 0
+}
+
+// RequestID returns the request ID carried by err, if err is (or wraps) an API
+// error, or an empty string otherwise. Spotinst support asks for it when
+// investigating failed requests.
+func RequestID(err error) string {
+	var e Error
+	if errors.As(err, &e) {
+		return e.RequestID
+	}
+	return ""
+}
+
+// IsRetryable reports whether err is transient, so the request that failed
+// with it is safe to retry: rate limiting, server errors other than 501 Not
+// Implemented, and network errors.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if code := StatusCode(err); code != 0 {
+		return errors.Is(err, ErrRateLimited) ||
+			(errors.Is(err, ErrServerError) && code != http.StatusNotImplemented)
+	}
+	return isNetworkError(err)
+}
+
 // DecodeBody is used to JSON decode a body
 func DecodeBody(resp *http.Response, out interface{}) error {
 	return json.NewDecoder(resp.Body).Decode(out)
@@ -72,6 +218,10 @@ func RequireOK(resp *http.Response, err error) (*http.Response, error) {
 	return resp, nil
 }
 
+// maxErrorBodySize is the maximum number of bytes of a non-API error body
+// (e.g. an HTML page returned by a proxy) to include in the error message.
+const maxErrorBodySize = 256
+
 // extractError is used to extract inner/logical errors from the response
 func extractError(resp *http.Response) error {
 	buf := bytes.NewBuffer(nil)
@@ -79,11 +229,19 @@ func extractError(resp *http.Response) error {
 	// TeeReader returns a Reader that writes to b what it reads from r.Body.
 	reader := io.TeeReader(resp.Body, buf)
 	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(reader)
 	resp.Body = ioutil.NopCloser(buf)
 
 	var out Response
-	if err := json.NewDecoder(reader).Decode(&out); err != nil {
-		return err
+	if err := json.Unmarshal(body, &out); err != nil {
+		// The body is not a Spotinst API envelope, e.g. an HTML page returned
+		// by a proxy. Fall back to an error based on the status code.
+		return Errors{{
+			Response: resp,
+			Code:     strconv.Itoa(resp.StatusCode),
+			Message:  statusMessage(resp.StatusCode, body),
+		}}
 	}
 
 	var errors Errors
@@ -108,3 +266,19 @@ func extractError(resp *http.Response) error {
 
 	return errors
 }
+
+// statusMessage returns the status text of the given code, followed by an
+// excerpt of the body, if any.
+func statusMessage(code int, body []byte) string {
+	msg := http.StatusText(code)
+
+	excerpt := strings.Join(strings.Fields(string(body)), " ")
+	if len(excerpt) > maxErrorBodySize {
+		excerpt = excerpt[:maxErrorBodySize] + "..."
+	}
+	if excerpt != "" {
+		msg = fmt.Sprintf("%s: %s", msg, excerpt)
+	}
+
+	return msg
+}
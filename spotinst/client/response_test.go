@@ -0,0 +1,97 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newResponse(code int, body string) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.spotinst.io/aws/ec2/group/sig-1", nil)
+	return &http.Response{
+		StatusCode: code,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestRequireOKErrors(t *testing.T) {
+	cases := map[string]struct {
+		Code      int
+		Body      string
+		Sentinel  error
+		RequestID string
+		ErrCode   string
+		Retryable bool
+	}{
+		"not_found": {
+			Code:      404,
+			Body:      `{"request":{"id":"req-1"},"response":{"errors":[{"code":"GROUP_DOESNT_EXIST","message":"Group not found"}]}}`,
+			Sentinel:  ErrNotFound,
+			RequestID: "req-1",
+			ErrCode:   "GROUP_DOESNT_EXIST",
+		},
+		"not_found_bad_request": {
+			Code:      400,
+			Body:      `{"request":{"id":"req-1"},"response":{"errors":[{"code":"GROUP_DOESNT_EXIST","message":"Group not found"}]}}`,
+			Sentinel:  ErrNotFound,
+			RequestID: "req-1",
+			ErrCode:   "GROUP_DOESNT_EXIST",
+		},
+		"validation": {
+			Code:      400,
+			Body:      `{"request":{"id":"req-2"},"response":{"errors":[{"code":"VALIDATION_ERROR","message":"bad","field":"capacity"}]}}`,
+			Sentinel:  ErrValidation,
+			RequestID: "req-2",
+			ErrCode:   "VALIDATION_ERROR",
+		},
+		"rate_limited": {
+			Code:      429,
+			Body:      `{"request":{"id":"req-3"},"response":{}}`,
+			Sentinel:  ErrRateLimited,
+			RequestID: "req-3",
+			ErrCode:   "429",
+			Retryable: true,
+		},
+		"proxy_html": {
+			Code:      502,
+			Body:      "<html>\n<body>Bad Gateway</body>\n</html>",
+			Sentinel:  ErrServerError,
+			ErrCode:   "502",
+			Retryable: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := RequireOK(newResponse(c.Code, c.Body), nil)
+			wrapped := fmt.Errorf("wrapped: %w", err)
+
+			assert.True(t, errors.Is(wrapped, c.Sentinel))
+			assert.False(t, errors.Is(wrapped, ErrUnauthorized))
+			if c.Sentinel == ErrNotFound {
+				assert.False(t, errors.Is(wrapped, ErrValidation))
+			}
+
+			var apiErr Error
+			if assert.True(t, errors.As(wrapped, &apiErr)) {
+				assert.Equal(t, c.ErrCode, apiErr.Code)
+				assert.Equal(t, c.Code, apiErr.StatusCode())
+			}
+
+			assert.Equal(t, c.Code, StatusCode(wrapped))
+			assert.Equal(t, c.RequestID, RequestID(wrapped))
+			assert.Equal(t, c.Retryable, IsRetryable(wrapped))
+		})
+	}
+}
+
+func TestRequireOKNonEnvelopeMessage(t *testing.T) {
+	_, err := RequireOK(newResponse(503, "<html>Service Unavailable</html>"), nil)
+	assert.Contains(t, err.Error(), "Service Unavailable: <html>Service Unavailable</html>")
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// shouldRetry reports whether the attempt of req that produced resp and err
+// should be retried according to the given policy.
+func shouldRetry(ctx context.Context, policy *spotinst.RetryPolicy, attempt int,
+	req *http.Request, resp *http.Response, err error) bool {
+	if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	safe := policy.RetryNonIdempotent || isIdempotent(req)
+	if err != nil {
+		return safe && policy.RetryNetworkErrors && isNetworkError(err)
+	}
+
+	// Rate-limited requests are rejected before being processed, so they can
+	// be retried whatever their method.
+	if !safe && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return policy.IsRetryableStatus(resp.StatusCode)
+}
+
+// notIdempotentKey is the context key flagging a request as not idempotent.
+type notIdempotentKey struct{}
+
+// isIdempotent reports whether req can safely be sent more than once, i.e.
+// whether its method is idempotent (GET, HEAD, OPTIONS, PUT or DELETE) and it
+// was not flagged as not idempotent with Request.NotIdempotent.
+func isIdempotent(req *http.Request) bool {
+	if notIdempotent, _ := req.Context().Value(notIdempotentKey{}).(bool); notIdempotent {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isNetworkError reports whether err was caused by a transport failure, such
+// as a refused connection or a connection closed by the remote peer.
+func isNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	// *url.Error implements net.Error itself, whatever the error it wraps
+	// (e.g. an error returned by a custom transport).
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// retryDelay returns the delay to wait before the given retry attempt. The
+// Retry-After header, if present, takes precedence over the policy's backoff.
+func retryDelay(policy *spotinst.RetryPolicy, attempt int, resp *http.Response) time.Duration {
+	if d, ok := retryAfter(resp); ok {
+		return policy.ClampDelay(d)
+	}
+	return policy.Backoff(attempt)
+}
+
+// retryAfter parses the Retry-After header, which holds either a number of
+// seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// rewindRequest returns a copy of req with a fresh body, so it can be sent
+// once again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	out := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("spotinst: unable to rewind request body")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		out.Body = body
+	}
+	return out, nil
+}
+
+// drainBody reads the remaining body of a discarded response and closes it,
+// allowing the underlying connection to be reused.
+func drainBody(resp *http.Response) {
+	if resp != nil && resp.Body != nil {
+		io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+	}
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNetworkError(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected bool
+	}{
+		"eof": {
+			Err:      &url.Error{Op: http.MethodPost, URL: "/", Err: io.EOF},
+			Expected: true,
+		},
+		"connection_refused": {
+			Err:      &url.Error{Op: http.MethodGet, URL: "/", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}},
+			Expected: true,
+		},
+		"custom_transport": {
+			Err:      &url.Error{Op: http.MethodGet, URL: "/", Err: errors.New("spotinst: custom transport failure")},
+			Expected: false,
+		},
+		"context_canceled": {
+			Err:      &url.Error{Op: http.MethodGet, URL: "/", Err: context.Canceled},
+			Expected: false,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, isNetworkError(c.Err))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/redact"
+)
+
+// ErrReadOnly is matched by the errors returned for mutating requests in
+// read-only mode. Use it with errors.Is, e.g. errors.Is(err, client.ErrReadOnly).
+var ErrReadOnly = errors.New("spotinst: mutating request rejected in read-only mode")
+
+// ReadOnlyError is returned for mutating requests in read-only mode; see
+// spotinst.Config.ReadOnly.
+type ReadOnlyError struct {
+	Method string
+	URL    string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("%v: %s %s", ErrReadOnly, e.Method, e.URL)
+}
+
+// Is reports whether target is ErrReadOnly.
+func (e *ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
+
+// DryRunRequestID is the request ID of the synthetic responses returned in
+// dry-run mode.
+const DryRunRequestID = "dry-run"
+
+// isMutating reports whether req may change the state of resources, i.e. it
+// is not a GET, HEAD or OPTIONS request.
+func isMutating(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// ReadOnlyMiddleware returns a middleware that rejects mutating requests with
+// a *ReadOnlyError, without sending them.
+func ReadOnlyMiddleware() spotinst.Middleware {
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			if isMutating(req) {
+				return nil, &ReadOnlyError{Method: req.Method, URL: req.URL.String()}
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// DryRunMiddleware returns a middleware that logs mutating requests with their
+// JSON body at info level, instead of sending them, and returns a synthetic
+// 200 OK response. The response's items hold the resource sent in the body,
+// if any, so that e.g. Create returns the resource it was given. Known secret
+// fields (see package redact) are redacted from the logged body.
+//
+// A nil logger logs to log.DefaultStdLogger.
+func DryRunMiddleware(logger log.Logger) spotinst.Middleware {
+	if logger == nil {
+		logger = log.DefaultStdLogger
+	}
+	leveled := log.Leveled(logger)
+
+	return func(next spotinst.Handler) spotinst.Handler {
+		return spotinst.HandlerFunc(func(req *http.Request) (*http.Response, error) {
+			if !isMutating(req) {
+				return next.Do(req)
+			}
+
+			body, err := peekRequestBody(req)
+			if err != nil {
+				return nil, err
+			}
+
+			leveled.Info("SPOTINST: Dry run, request not sent",
+				"method", req.Method,
+				"url", req.URL.String(),
+				"body", string(redact.JSON(body)))
+
+			return dryRunResponse(req, body)
+		})
+	}
+}
+
+// dryRunResponse returns the synthetic response to a request in dry-run mode.
+func dryRunResponse(req *http.Request, body []byte) (*http.Response, error) {
+	var out Response
+	out.Request.ID = DryRunRequestID
+	out.Response.Items = []json.RawMessage{}
+
+	// Echo the resource of bodies such as {"group": {...}}.
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(body, &obj); err == nil && len(obj) == 1 {
+		for _, item := range obj {
+			if item = bytes.TrimSpace(item); len(item) > 0 && item[0] == '{' {
+				out.Response.Items = append(out.Response.Items, item)
+			}
+		}
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
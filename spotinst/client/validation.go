@@ -0,0 +1,26 @@
+package client
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/validation"
+)
+
+// Validate validates the given model if enabled by the client's config (see
+// spotinst.Config.Validation), and returns the validation.Errors found, if
+// any. It is called by Update methods before sending their models.
+func (c *Client) Validate(model validation.Validatable) error {
+	if !spotinst.BoolValue(c.config.Validation) {
+		return nil
+	}
+	return model.Validate()
+}
+
+// ValidateCreate is like Validate, but also requires the fields that must be
+// set to create the model. It is called by Create methods before sending
+// their models.
+func (c *Client) ValidateCreate(model validation.Validatable) error {
+	if !spotinst.BoolValue(c.config.Validation) {
+		return nil
+	}
+	return model.ValidateCreate()
+}
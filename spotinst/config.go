@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/spotinst/spotinst-sdk-go/spotinst/audit"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/ratelimit"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/util/useragent"
 )
 
@@ -36,7 +38,8 @@ type Config struct {
 	// The credentials object to use when signing requests.
 	//
 	// Defaults to a chain of credential providers to search for credentials in
-	// environment variables and shared credential file.
+	// environment variables, the token file pointed to by SPOTINST_TOKEN_FILE
+	// and shared credential file.
 	Credentials *credentials.Credentials
 
 	// The logger writer interface to write logging messages to.
@@ -44,6 +47,59 @@ type Config struct {
 	// Defaults to standard out.
 	Logger log.Logger
 
+	// The retry policy to apply to requests that failed with a transient error.
+	//
+	// Defaults to DefaultRetryPolicy. Use NoRetryPolicy to disable retries.
+	RetryPolicy *RetryPolicy
+
+	// The rate limiter to apply before sending requests.
+	//
+	// The limiter is shared by every service client created from the same
+	// session, so all of them draw from the same budget. Defaults to nil,
+	// meaning requests are not limited.
+	RateLimiter *ratelimit.Limiter
+
+	// Whether to log mutating requests (e.g. from Create, Update, Delete or
+	// Roll) with their full JSON body instead of sending them, and return a
+	// synthetic response. Read requests are sent as usual.
+	//
+	// Defaults to false.
+	DryRun *bool
+
+	// Whether to reject mutating requests with a *client.ReadOnlyError,
+	// without sending them. It takes precedence over DryRun.
+	//
+	// Defaults to false.
+	ReadOnly *bool
+
+	// Whether to validate models (e.g. aws.Group) in Create and Update methods
+	// before sending them, and return the validation.Errors found instead.
+	//
+	// Defaults to false.
+	Validation *bool
+
+	// Whether to send back the JSON fields of models that the SDK does not
+	// know about (e.g. fields added by a newer version of the API) as they
+	// were read, so a Read-modify-Update cycle does not wipe them. When
+	// disabled, only the fields modeled by the SDK are sent.
+	//
+	// Defaults to true.
+	PreserveUnknownFields *bool
+
+	// The sink to record mutating calls to, e.g. an audit.FileSink. Calls are
+	// recorded once they complete, whether they succeeded or not. Calls that
+	// are not sent, in read-only or dry-run mode, are not recorded.
+	//
+	// Defaults to nil, meaning calls are not recorded.
+	AuditSink audit.Sink
+
+	// The middlewares to run around every request sent by the SDK's API
+	// clients, e.g. to add tracing headers or collect metrics.
+	//
+	// Middlewares run once per attempt, after rate limiting and before the
+	// request is logged and sent. Merging configs appends their middlewares.
+	Middlewares []Middleware
+
 	// The User-Agent and Content-Type HTTP headers to set when invoking HTTP
 	// requests.
 	UserAgent, ContentType string
@@ -106,8 +162,10 @@ func DefaultConfig() *Config {
 		HTTPClient:  DefaultHTTPClient(),
 		UserAgent:   DefaultUserAgent(),
 		ContentType: DefaultContentType(),
+		RetryPolicy: DefaultRetryPolicy(),
 		Credentials: credentials.NewChainCredentials(
 			new(credentials.EnvProvider),
+			new(credentials.TokenFileProvider),
 			new(credentials.FileProvider),
 		),
 	}
@@ -151,6 +209,63 @@ func (c *Config) WithLogger(logger log.Logger) *Config {
 	return c
 }
 
+// WithRetryPolicy defines the retry policy.
+func (c *Config) WithRetryPolicy(policy *RetryPolicy) *Config {
+	c.RetryPolicy = policy
+	return c
+}
+
+// WithRateLimiter defines the rate limiter.
+func (c *Config) WithRateLimiter(limiter *ratelimit.Limiter) *Config {
+	c.RateLimiter = limiter
+	return c
+}
+
+// WithRateLimit defines a rate limiter allowing up to rps requests per second
+// on average, with bursts of up to burst requests.
+func (c *Config) WithRateLimit(rps float64, burst int) *Config {
+	c.RateLimiter = ratelimit.New(rps, burst)
+	return c
+}
+
+// WithDryRun defines whether mutating requests are logged instead of being
+// sent.
+func (c *Config) WithDryRun(enabled bool) *Config {
+	c.DryRun = Bool(enabled)
+	return c
+}
+
+// WithReadOnly defines whether mutating requests are rejected.
+func (c *Config) WithReadOnly(enabled bool) *Config {
+	c.ReadOnly = Bool(enabled)
+	return c
+}
+
+// WithValidation defines whether models are validated before being sent.
+func (c *Config) WithValidation(enabled bool) *Config {
+	c.Validation = Bool(enabled)
+	return c
+}
+
+// WithPreserveUnknownFields defines whether the JSON fields of models that
+// the SDK does not know about are sent back.
+func (c *Config) WithPreserveUnknownFields(enabled bool) *Config {
+	c.PreserveUnknownFields = Bool(enabled)
+	return c
+}
+
+// WithAuditSink defines the sink to record mutating calls to.
+func (c *Config) WithAuditSink(sink audit.Sink) *Config {
+	c.AuditSink = sink
+	return c
+}
+
+// WithMiddleware appends middlewares to the chain.
+func (c *Config) WithMiddleware(mws ...Middleware) *Config {
+	c.Middlewares = append(c.Middlewares, mws...)
+	return c
+}
+
 // Merge merges the passed in configs into the existing config object.
 func (c *Config) Merge(cfgs ...*Config) {
 	for _, cfg := range cfgs {
@@ -180,4 +295,30 @@ func mergeConfig(c1, c2 *Config) {
 	if c2.Logger != nil {
 		c1.Logger = c2.Logger
 	}
+	if c2.RetryPolicy != nil {
+		c1.RetryPolicy = c2.RetryPolicy
+	}
+	if c2.RateLimiter != nil {
+		c1.RateLimiter = c2.RateLimiter
+	}
+	if c2.DryRun != nil {
+		c1.DryRun = c2.DryRun
+	}
+	if c2.ReadOnly != nil {
+		c1.ReadOnly = c2.ReadOnly
+	}
+	if c2.Validation != nil {
+		c1.Validation = c2.Validation
+	}
+	if c2.PreserveUnknownFields != nil {
+		c1.PreserveUnknownFields = c2.PreserveUnknownFields
+	}
+	if c2.AuditSink != nil {
+		c1.AuditSink = c2.AuditSink
+	}
+	if len(c2.Middlewares) > 0 {
+		mws := make([]Middleware, 0, len(c1.Middlewares)+len(c2.Middlewares))
+		mws = append(mws, c1.Middlewares...)
+		c1.Middlewares = append(mws, c2.Middlewares...)
+	}
 }
@@ -2,6 +2,7 @@ package credentials
 
 import (
 	"sync"
+	"time"
 )
 
 // A Credentials provides synchronous safe retrieval of Spotinst credentials.
@@ -13,12 +14,16 @@ import (
 //
 // The first Credentials.Get() will always call Provider.Retrieve() to get the
 // first instance of the credentials Value. All calls to Get() after that will
-// return the cached credentials Value.
+// return the cached credentials Value, until the Provider's credentials expire
+// if it implements ExpiryChecker or Expirer.
 type Credentials struct {
 	provider     Provider
 	mu           sync.Mutex
 	forceRefresh bool
 	creds        Value
+
+	// now, if set, is used instead of time.Now.
+	now func() time.Time
 }
 
 // NewCredentials returns a pointer to a new Credentials with the provider set.
@@ -33,12 +38,13 @@ func NewCredentials(provider Provider) *Credentials {
 // to be retrieved.
 //
 // Will return the cached credentials Value. If the credentials Value is empty
-// the Provider's Retrieve() will be called to refresh the credentials.
+// or expired, the Provider's Retrieve() will be called to refresh the
+// credentials.
 func (c *Credentials) Get() (Value, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.creds.Token == "" || c.forceRefresh {
+	if c.creds.Token == "" || c.forceRefresh || c.isExpired() {
 		creds, err := c.provider.Retrieve()
 		if err != nil {
 			return Value{}, err
@@ -58,3 +64,34 @@ func (c *Credentials) Refresh() {
 
 	c.forceRefresh = true
 }
+
+// IsExpired reports whether the credentials are expired, and will be
+// retrieved again on the next call to Get().
+func (c *Credentials) IsExpired() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.forceRefresh || c.isExpired()
+}
+
+// ExpiresAt returns the time at which the credentials expire, or the zero time
+// if unknown: they never expire or the Provider does not implement Expirer.
+func (c *Credentials) ExpiresAt() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.provider.(Expirer); ok {
+		return e.ExpiresAt()
+	}
+	return time.Time{}
+}
+
+func (c *Credentials) isExpired() bool {
+	switch p := c.provider.(type) {
+	case ExpiryChecker:
+		return p.IsExpired()
+	case Expirer:
+		return isExpired(p.ExpiresAt(), c.now)
+	}
+	return false
+}
@@ -6,7 +6,9 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 type mockProvider struct {
@@ -22,6 +24,59 @@ func (m *mockProvider) Retrieve() (Value, error) {
 }
 func (m *mockProvider) String() string { return "mock" }
 
+type expiringProvider struct {
+	Expiry
+	tokens []string
+	calls  int
+}
+
+func (p *expiringProvider) Retrieve() (Value, error) {
+	token := p.tokens[p.calls]
+	p.calls++
+	p.SetExpiration(p.CurrentTime().Add(time.Hour), time.Minute)
+	return Value{Token: token}, nil
+}
+func (p *expiringProvider) String() string { return "expiring" }
+
+func TestExpiringCredentials(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	p := &expiringProvider{tokens: []string{"token1", "token2"}}
+	p.CurrentTime = clock
+	creds := NewCredentials(p)
+	creds.now = clock
+
+	for i, step := range []struct {
+		Elapsed time.Duration
+		Token   string
+	}{
+		{0, "token1"},
+		{58 * time.Minute, "token1"},
+		{time.Minute, "token2"}, // within the expiry window
+		{time.Minute, "token2"},
+	} {
+		now = now.Add(step.Elapsed)
+		value, err := creds.Get()
+		if err != nil {
+			t.Fatalf("%d: expect: no error, got: %q", i, err)
+		}
+		if e, a := step.Token, value.Token; e != a {
+			t.Errorf("%d: expect: %q, got: %q", i, e, a)
+		}
+	}
+
+	if e, a := now.Add(58*time.Minute), creds.ExpiresAt(); !e.Equal(a) {
+		t.Errorf("expect: %v, got: %v", e, a)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expect: not expired")
+	}
+	if e, a := 2, p.calls; e != a {
+		t.Errorf("expect: %d retrievals, got: %d", e, a)
+	}
+}
+
 func TestChainCredentials(t *testing.T) {
 	cases := map[string]struct {
 		Providers []Provider
@@ -322,3 +377,199 @@ func TestStaticCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessCredentials(t *testing.T) {
+	cases := map[string]struct {
+		Command    string
+		Expected   Value
+		Expiration time.Time
+		Err        string
+	}{
+		"empty_command": {
+			Err: "spotinst: process credentials command is empty",
+		},
+		"failed_command": {
+			Command: "echo oops >&2; exit 1",
+			Err:     "oops",
+		},
+		"invalid_output": {
+			Command: "echo not-json",
+			Err:     "spotinst: failed to parse process credentials output",
+		},
+		"empty_token": {
+			Command: `echo '{"account":"account"}'`,
+			Err:     "spotinst: process credentials do not contain token",
+		},
+		"full_credentials": {
+			Command: `echo '{"token":"token","account":"account","expiration":"2030-01-01T00:00:00Z"}'`,
+			Expected: Value{
+				ProviderName: ProcessCredentialsProviderName,
+				Token:        "token",
+				Account:      "account",
+			},
+			Expiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &ProcessProvider{Command: tc.Command}
+			creds, err := p.Retrieve()
+			if tc.Err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.Err) {
+					t.Errorf("expect: %q to be in: %v", tc.Err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect: no error, got: %q", err)
+			}
+			if e, a := tc.Expected, creds; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect: %v, got: %v", e, a)
+			}
+			if e, a := tc.Expiration, p.ExpiresAt(); !e.Equal(a) {
+				t.Errorf("expect: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestTokenFileCredentials(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(filename, []byte("token1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	creds := NewChainCredentials(
+		&TokenFileProvider{Filename: filename, Account: "account", CheckInterval: -1},
+		&StaticProvider{Value: Value{Token: "static"}},
+	)
+
+	value, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expect: no error, got: %q", err)
+	}
+	expected := Value{
+		ProviderName: TokenFileCredentialsProviderName,
+		Token:        "token1",
+		Account:      "account",
+	}
+	if e, a := expected, value; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect: %v, got: %v", e, a)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expect: not expired")
+	}
+
+	// Rotate the token.
+	if err := os.WriteFile(filename, []byte("token-2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !creds.IsExpired() {
+		t.Errorf("expect: expired")
+	}
+
+	value, err = creds.Get()
+	if err != nil {
+		t.Fatalf("expect: no error, got: %q", err)
+	}
+	if e, a := "token-2", value.Token; e != a {
+		t.Errorf("expect: %q, got: %q", e, a)
+	}
+
+	// Keep the cached token while the file cannot be checked, e.g. while it
+	// is being replaced.
+	if err := os.Remove(filename); err != nil {
+		t.Fatal(err)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expect: not expired")
+	}
+	value, err = creds.Get()
+	if err != nil {
+		t.Fatalf("expect: no error, got: %q", err)
+	}
+	if e, a := "token-2", value.Token; e != a {
+		t.Errorf("expect: %q, got: %q", e, a)
+	}
+
+	// Fall back to the next provider once the credentials are refreshed, e.g.
+	// after a 401 Unauthorized.
+	creds.Refresh()
+	value, err = creds.Get()
+	if err != nil {
+		t.Fatalf("expect: no error, got: %q", err)
+	}
+	if e, a := "static", value.Token; e != a {
+		t.Errorf("expect: %q, got: %q", e, a)
+	}
+}
+
+func TestTokenFileConcurrency(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(filename, []byte("token1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Run with -race to check that the providers' state is guarded.
+	p := &ChainProvider{Providers: []Provider{&TokenFileProvider{Filename: filename, CheckInterval: -1}}}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := p.Retrieve(); err != nil {
+					t.Errorf("expect: no error, got: %q", err)
+					return
+				}
+				p.IsExpired()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTokenFileCheckInterval(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(filename, []byte("token1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &TokenFileProvider{Filename: filename, CheckInterval: time.Hour}
+	creds := NewCredentials(p)
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expect: no error, got: %q", err)
+	}
+
+	// Rotate the token: the file is not checked again within the interval.
+	if err := os.WriteFile(filename, []byte("token-2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expect: not expired")
+	}
+
+	// Once the interval elapsed, the file is checked and read again.
+	p.checkedAt = time.Now().Add(-time.Hour)
+	value, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expect: no error, got: %q", err)
+	}
+	if e, a := "token-2", value.Token; e != a {
+		t.Errorf("expect: %q, got: %q", e, a)
+	}
+
+	// A refresh, e.g. after a 401 Unauthorized, reads it again at once.
+	if err := os.WriteFile(filename, []byte("token-3\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	creds.Refresh()
+	value, err = creds.Get()
+	if err != nil {
+		t.Fatalf("expect: no error, got: %q", err)
+	}
+	if e, a := "token-3", value.Token; e != a {
+		t.Errorf("expect: %q, got: %q", e, a)
+	}
+}
@@ -0,0 +1,74 @@
+package credentials
+
+import (
+	"time"
+)
+
+// An Expirer is a Provider whose credentials expire. Credentials retrieves
+// the credentials again once they expired. Providers may embed Expiry to
+// implement it.
+type Expirer interface {
+	// ExpiresAt returns the time at which the credentials retrieved last
+	// expire, or the zero time if they never expire.
+	ExpiresAt() time.Time
+}
+
+// An ExpiryChecker is a Provider that reports itself whether its credentials
+// expired, e.g. because their source changed. It takes precedence over
+// Expirer.
+type ExpiryChecker interface {
+	// IsExpired reports whether the credentials retrieved last expired.
+	IsExpired() bool
+}
+
+// Expiry provides shared expiration logic to be used by credentials providers
+// to implement Expirer. Its zero value never expires.
+//
+//	type MyProvider struct {
+//		credentials.Expiry
+//		...
+//	}
+//
+//	// Retrieve implements the Provider interface.
+//	func (p *MyProvider) Retrieve() (credentials.Value, error) {
+//		...
+//		p.SetExpiration(expiration, 5*time.Minute)
+//		return value, nil
+//	}
+type Expiry struct {
+	// The time at which the credentials expire.
+	expiration time.Time
+
+	// CurrentTime, if set, is used instead of time.Now. It is useful in tests.
+	CurrentTime func() time.Time
+}
+
+// SetExpiration sets the expiration time of the credentials. The window moves
+// the expiration earlier, so that the credentials are refreshed before they
+// actually expire and requests sent meanwhile do not fail.
+func (e *Expiry) SetExpiration(expiration time.Time, window time.Duration) {
+	e.expiration = expiration
+	if window > 0 && !expiration.IsZero() {
+		e.expiration = expiration.Add(-window)
+	}
+}
+
+// IsExpired implements the ExpiryChecker interface.
+func (e *Expiry) IsExpired() bool {
+	return isExpired(e.expiration, e.CurrentTime)
+}
+
+// ExpiresAt implements the Expirer interface.
+func (e *Expiry) ExpiresAt() time.Time {
+	return e.expiration
+}
+
+func isExpired(expiration time.Time, now func() time.Time) bool {
+	if expiration.IsZero() {
+		return false
+	}
+	if now == nil {
+		now = time.Now
+	}
+	return !now().Before(expiration)
+}
@@ -3,6 +3,8 @@ package credentials
 import (
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 )
 
 // ErrNoValidProvidersFoundInChain Is returned when there are no valid credentials
@@ -34,7 +36,9 @@ var ErrNoValidProvidersFoundInChain = errors.New("spotinst: no valid credentials
 //	)
 type ChainProvider struct {
 	Providers []Provider
-	active    Provider
+
+	mu     sync.Mutex
+	active Provider
 }
 
 // NewChainCredentials returns a pointer to a new Credentials object
@@ -52,12 +56,12 @@ func (c *ChainProvider) Retrieve() (Value, error) {
 	for _, p := range c.Providers {
 		value, err := p.Retrieve()
 		if err == nil {
-			c.active = p
+			c.setActive(p)
 			return value, nil
 		}
 		errs = append(errs, err)
 	}
-	c.active = nil
+	c.setActive(nil)
 
 	err := ErrNoValidProvidersFoundInChain
 	if len(errs) > 0 {
@@ -67,6 +71,41 @@ func (c *ChainProvider) Retrieve() (Value, error) {
 	return Value{}, err
 }
 
+// ExpiresAt implements the Expirer interface. It returns the expiration time
+// of the cached provider's credentials, if it implements Expirer.
+func (c *ChainProvider) ExpiresAt() time.Time {
+	if e, ok := c.getActive().(Expirer); ok {
+		return e.ExpiresAt()
+	}
+	return time.Time{}
+}
+
+// IsExpired implements the ExpiryChecker interface. It reports whether the
+// cached provider's credentials expired.
+func (c *ChainProvider) IsExpired() bool {
+	switch p := c.getActive().(type) {
+	case ExpiryChecker:
+		return p.IsExpired()
+	case Expirer:
+		return isExpired(p.ExpiresAt(), nil)
+	}
+	return false
+}
+
+// getActive returns the cached provider, if any.
+func (c *ChainProvider) getActive() Provider {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.active
+}
+
+// setActive caches the given provider.
+func (c *ChainProvider) setActive(p Provider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.active = p
+}
+
 func (c *ChainProvider) String() string {
 	var out string
 	for i, provider := range c.Providers {
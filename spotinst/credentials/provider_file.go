@@ -22,6 +22,11 @@ const (
 	// FileCredentialsEnvVarProfile specifies the name of the environment variable
 	// points to a profile name to use when loading credentials.
 	FileCredentialsEnvVarProfile = "SPOTINST_CREDENTIALS_PROFILE"
+
+	// FileCredentialsEnvVarSharedProfile specifies the name of the environment
+	// variable points to the profile of the shared config file, which is used
+	// if FileCredentialsEnvVarProfile is not set.
+	FileCredentialsEnvVarSharedProfile = "SPOTINST_PROFILE"
 )
 
 var (
@@ -103,6 +108,9 @@ func (p *FileProvider) profile() string {
 		if p.Profile = os.Getenv(FileCredentialsEnvVarProfile); p.Profile != "" {
 			return p.Profile
 		}
+		if p.Profile = os.Getenv(FileCredentialsEnvVarSharedProfile); p.Profile != "" {
+			return p.Profile
+		}
 
 		p.Profile = DefaultProfile()
 	}
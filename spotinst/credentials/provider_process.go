@@ -0,0 +1,138 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	// ProcessCredentialsProviderName provides a name of Process provider.
+	ProcessCredentialsProviderName = "ProcessCredentialsProvider"
+
+	// DefaultProcessTimeout is the default time allowed for the command of a
+	// Process provider to complete.
+	DefaultProcessTimeout = time.Minute
+)
+
+var (
+	// ErrProcessCredentialsCommandEmpty is emitted when the provider has no
+	// command to execute.
+	ErrProcessCredentialsCommandEmpty = errors.New("spotinst: process credentials command is empty")
+
+	// ErrProcessCredentialsTokenNotFound is emitted when the output of the
+	// command does not contain a valid token.
+	ErrProcessCredentialsTokenNotFound = errors.New("spotinst: process credentials do not contain token")
+)
+
+// A ProcessProvider retrieves credentials from the output of an external
+// command, e.g. a helper fetching them from a secret manager. The command is
+// run by the shell and must print a JSON object to its standard output:
+//
+//	{
+//		"token": "...",
+//		"account": "act-12345678",
+//		"expiration": "2020-01-01T00:00:00Z"
+//	}
+//
+// The account and expiration are optional. If an expiration is given, the
+// command is run again once the credentials expire.
+type ProcessProvider struct {
+	Expiry
+
+	// The command to run, e.g. "vault-helper spotinst".
+	Command string
+
+	// The maximum time allowed for the command to complete. Defaults to
+	// DefaultProcessTimeout.
+	Timeout time.Duration
+
+	// ExpiryWindow allows the credentials to be refreshed before they
+	// actually expire, e.g. 5*time.Minute to refresh them 5 minutes early.
+	ExpiryWindow time.Duration
+}
+
+// NewProcessCredentials returns a pointer to a new Credentials object wrapping
+// the process provider.
+func NewProcessCredentials(command string) *Credentials {
+	return NewCredentials(&ProcessProvider{
+		Command: command,
+	})
+}
+
+// processOutput is the output expected from the command.
+type processOutput struct {
+	Token      string     `json:"token"`
+	Account    string     `json:"account"`
+	Expiration *time.Time `json:"expiration"`
+}
+
+// Retrieve runs the command and parses its output.
+func (p *ProcessProvider) Retrieve() (Value, error) {
+	value := Value{ProviderName: ProcessCredentialsProviderName}
+
+	if strings.TrimSpace(p.Command) == "" {
+		return value, ErrProcessCredentialsCommandEmpty
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultProcessTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := shellCommand(ctx, p.Command)
+	cmd.Env = os.Environ()
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return value, fmt.Errorf("spotinst: process credentials command failed: %v: %s",
+			err, strings.TrimSpace(stderr.String()))
+	}
+
+	var out processOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return value, fmt.Errorf("spotinst: failed to parse process credentials output: %v", err)
+	}
+	if out.Token == "" {
+		return value, ErrProcessCredentialsTokenNotFound
+	}
+
+	var expiration time.Time
+	if out.Expiration != nil {
+		expiration = *out.Expiration
+	}
+	p.SetExpiration(expiration, p.ExpiryWindow)
+
+	value.Token = out.Token
+	value.Account = out.Account
+
+	return value, nil
+}
+
+func (p *ProcessProvider) String() string {
+	return ProcessCredentialsProviderName
+}
+
+// shellCommand returns a command running the given command line with the
+// platform's shell.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
@@ -0,0 +1,149 @@
+package credentials
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// TokenFileCredentialsProviderName provides a name of TokenFile provider.
+	TokenFileCredentialsProviderName = "TokenFileCredentialsProvider"
+
+	// TokenFileCredentialsEnvVarFile specifies the name of the environment
+	// variable points to the location of the token file.
+	TokenFileCredentialsEnvVarFile = "SPOTINST_TOKEN_FILE"
+
+	// DefaultTokenFileCheckInterval is the default minimum interval between
+	// two checks of a token file for changes.
+	DefaultTokenFileCheckInterval = 10 * time.Second
+)
+
+var (
+	// ErrTokenFileCredentialsFileNotSet is emitted when the provider has no
+	// token file to read.
+	ErrTokenFileCredentialsFileNotSet = errors.New("spotinst: token file not set")
+
+	// ErrTokenFileCredentialsTokenNotFound is emitted when the token file is
+	// empty.
+	ErrTokenFileCredentialsTokenNotFound = errors.New("spotinst: token file is empty")
+)
+
+// A TokenFileProvider retrieves a bare token from a file, e.g. a Kubernetes
+// secret mounted into a pod. The file is checked for changes at most once per
+// CheckInterval, and read again if it changed, so rotated tokens are picked up
+// without restarting the process. They are also picked up as soon as a request
+// fails with 401 Unauthorized, which refreshes the credentials.
+type TokenFileProvider struct {
+	// Path to the token file.
+	//
+	// If empty will look for TokenFileCredentialsEnvVarFile env variable.
+	Filename string
+
+	// Spotinst account ID.
+	//
+	// If empty will look for EnvCredentialsVarAccount env variable.
+	Account string
+
+	// The minimum interval between two checks of the file for changes.
+	//
+	// If zero will use DefaultTokenFileCheckInterval. If negative, the file
+	// is checked whenever credentials are requested.
+	CheckInterval time.Duration
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// The modification time and size of the file when it was read last.
+	modTime time.Time
+	size    int64
+
+	// The time the file was checked for changes last.
+	checkedAt time.Time
+}
+
+// NewTokenFileCredentials returns a pointer to a new Credentials object
+// wrapping the token file provider.
+func NewTokenFileCredentials(filename, account string) *Credentials {
+	return NewCredentials(&TokenFileProvider{
+		Filename: filename,
+		Account:  account,
+	})
+}
+
+// Retrieve reads the token from the file.
+func (p *TokenFileProvider) Retrieve() (Value, error) {
+	value := Value{ProviderName: TokenFileCredentialsProviderName}
+
+	filename := p.filename()
+	if filename == "" {
+		return value, ErrTokenFileCredentialsFileNotSet
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return value, err
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return value, err
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return value, ErrTokenFileCredentialsTokenNotFound
+	}
+
+	p.mu.Lock()
+	p.modTime, p.size = info.ModTime(), info.Size()
+	p.checkedAt = time.Now()
+	p.mu.Unlock()
+
+	value.Token = token
+	value.Account = p.Account
+	if value.Account == "" {
+		value.Account = os.Getenv(EnvCredentialsVarAccount)
+	}
+
+	return value, nil
+}
+
+// IsExpired implements the ExpiryChecker interface. It reports whether the
+// file changed since it was read last, checking it at most once per
+// CheckInterval. If the file cannot be checked, e.g. while it is being
+// replaced, the token read last is kept.
+func (p *TokenFileProvider) IsExpired() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	interval := p.CheckInterval
+	if interval == 0 {
+		interval = DefaultTokenFileCheckInterval
+	}
+	if interval > 0 && time.Since(p.checkedAt) < interval {
+		return false
+	}
+	p.checkedAt = time.Now()
+
+	info, err := os.Stat(p.filename())
+	if err != nil {
+		return false
+	}
+	return !info.ModTime().Equal(p.modTime) || info.Size() != p.size
+}
+
+func (p *TokenFileProvider) String() string {
+	return TokenFileCredentialsProviderName
+}
+
+// filename returns the filename to read the token from.
+func (p *TokenFileProvider) filename() string {
+	if p.Filename != "" {
+		return p.Filename
+	}
+	return os.Getenv(TokenFileCredentialsEnvVarFile)
+}
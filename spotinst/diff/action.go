@@ -0,0 +1,49 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An Action is what applying a desired state did, or would do in plan-only
+// mode, to a resource.
+type Action int
+
+const (
+	// ActionUnchanged means the resource was already in the desired state.
+	ActionUnchanged Action = iota
+
+	// ActionCreated means the resource did not exist and was created.
+	ActionCreated
+
+	// ActionUpdated means the resource existed and its changed fields were
+	// updated.
+	ActionUpdated
+)
+
+var actionNames = map[Action]string{
+	ActionUnchanged: "unchanged",
+	ActionCreated:   "created",
+	ActionUpdated:   "updated",
+}
+
+// String returns the name of the action, e.g. "created".
+func (a Action) String() string {
+	if name, ok := actionNames[a]; ok {
+		return name
+	}
+	return fmt.Sprintf("Action(%d)", int(a))
+}
+
+// Under reports whether any of the changes is to a field at or under one of
+// the given paths, e.g. "compute.launchSpecification".
+func (cs Changes) Under(paths ...string) bool {
+	for _, c := range cs {
+		for _, path := range paths {
+			if c.Path == path || strings.HasPrefix(c.Path, path+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
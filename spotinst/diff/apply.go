@@ -0,0 +1,173 @@
+package diff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+// A Resource provides the operations Apply needs to reconcile a kind of
+// resource, e.g. an Elastigroup, with its desired state. The resources are
+// pointers to the model of the kind, e.g. an *aws.Group.
+type Resource struct {
+	// The name of the kind in errors, e.g. "group".
+	Kind string
+
+	// Read returns the resource with the given ID. Errors matching
+	// client.ErrNotFound mean that it does not exist.
+	Read func(ctx context.Context, id string) (interface{}, error)
+
+	// List returns all the resources, as a slice of models, e.g. []*aws.Group.
+	List func(ctx context.Context) (interface{}, error)
+
+	// Create creates the given resource and returns it.
+	Create func(ctx context.Context, desired interface{}) (interface{}, error)
+
+	// Update sends the given update, as computed by Diff, and returns the
+	// updated resource.
+	Update func(ctx context.Context, update interface{}) (interface{}, error)
+
+	// Roll, if set, rolls the resource with the given ID after an update
+	// changing a field at or under one of RollPaths.
+	Roll      func(ctx context.Context, id string) error
+	RollPaths []string
+}
+
+// A Result is what Apply did, or would do in plan-only mode, to a resource.
+type Result struct {
+	// The resource as created or updated, or the existing resource, if any,
+	// if unchanged or in plan-only mode.
+	Resource interface{}
+
+	// What was done, or would be done in plan-only mode, to the resource.
+	Action Action
+
+	// The changes made, or to be made in plan-only mode, to the resource.
+	Changes Changes
+}
+
+// Apply reconciles a resource with its desired state: it looks up the
+// existing resource by the ID of desired if set, or else by its name, creates
+// it if it does not exist, or else updates the fields that desired changes
+// only, as computed by Diff, and rolls it if needed. In plan-only mode, it
+// only returns what it would do. If the roll following an update fails, the
+// result is returned along with the error.
+func Apply(ctx context.Context, r *Resource, desired interface{}, planOnly bool) (*Result, error) {
+	current, err := find(ctx, r, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	update, changes, err := Diff(current, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Resource: current,
+		Changes:  changes,
+	}
+
+	switch {
+	case current == nil:
+		result.Action = ActionCreated
+	case len(changes) > 0:
+		result.Action = ActionUpdated
+	default:
+		return result, nil
+	}
+
+	if planOnly {
+		return result, nil
+	}
+
+	if current == nil {
+		if result.Resource, err = r.Create(ctx, desired); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	if result.Resource, err = r.Update(ctx, update); err != nil {
+		return nil, err
+	}
+
+	if r.Roll != nil && changes.Under(r.RollPaths...) {
+		if err = r.Roll(ctx, stringField(reflect.ValueOf(current), "id")); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// find returns the existing resource with the ID of desired if set, or else
+// with its name, or nil if there is none.
+func find(ctx context.Context, r *Resource, desired interface{}) (interface{}, error) {
+	dv := reflect.ValueOf(desired)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return nil, fmt.Errorf("spotinst: %s must be set", r.Kind)
+	}
+
+	if id := stringField(dv, "id"); id != "" {
+		current, err := r.Read(ctx, id)
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if v := reflect.ValueOf(current); !v.IsValid() || v.IsNil() {
+			return nil, nil
+		}
+		return current, nil
+	}
+
+	name := stringField(dv, "name")
+	if name == "" {
+		return nil, fmt.Errorf("spotinst: %s must have an ID or a name", r.Kind)
+	}
+
+	list, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found interface{}
+	lv := reflect.ValueOf(list)
+	for i := 0; lv.IsValid() && i < lv.Len(); i++ {
+		if stringField(lv.Index(i), "name") != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("spotinst: more than one %s named %q", r.Kind, name)
+		}
+		found = lv.Index(i).Interface()
+	}
+
+	return found, nil
+}
+
+// stringField returns the value of the *string field with the given JSON name
+// of the given model, or an empty string if it is not set.
+func stringField(v reflect.Value, name string) string {
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ""
+	}
+	v = v.Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if sf.PkgPath != "" || jsonName(sf) != name {
+			continue
+		}
+		if f := v.Field(i); f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() == reflect.String {
+			return f.Elem().String()
+		}
+		return ""
+	}
+	return ""
+}
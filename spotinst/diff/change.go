@@ -0,0 +1,108 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/redact"
+)
+
+// Op is the kind of a change.
+type Op int
+
+const (
+	// OpAdd is the addition of a field missing from the current state.
+	OpAdd Op = iota
+
+	// OpUpdate is the update of a field present in both states.
+	OpUpdate
+
+	// OpRemove is the removal of a field missing from the desired state.
+	OpRemove
+)
+
+var opNames = map[Op]string{
+	OpAdd:    "add",
+	OpUpdate: "update",
+	OpRemove: "remove",
+}
+
+// String returns the name of the op, e.g. "add".
+func (op Op) String() string {
+	if name, ok := opNames[op]; ok {
+		return name
+	}
+	return fmt.Sprintf("Op(%d)", int(op))
+}
+
+// symbol returns the symbol of the op in a plan, e.g. "+".
+func (op Op) symbol() string {
+	switch op {
+	case OpAdd:
+		return "+"
+	case OpRemove:
+		return "-"
+	}
+	return "~"
+}
+
+// A Change describes a field that differs between the current and the desired
+// state.
+type Change struct {
+	// The kind of the change.
+	Op Op
+
+	// The JSON path of the field, e.g. "compute.launchSpecification.imageId".
+	Path string
+
+	// The current and the desired values of the field. Pointers to basic
+	// values are dereferenced. From is nil for additions, and To for removals.
+	From, To interface{}
+}
+
+// String returns a human-readable form of the change, e.g.:
+//
+//	~ capacity.target: 2 => 3
+//
+// The values of sensitive fields (see package redact) are redacted.
+func (c *Change) String() string {
+	switch c.Op {
+	case OpAdd:
+		return fmt.Sprintf("%s %s: %s", c.Op.symbol(), c.Path, c.format(c.To))
+	case OpRemove:
+		return fmt.Sprintf("%s %s: %s", c.Op.symbol(), c.Path, c.format(c.From))
+	}
+	return fmt.Sprintf("%s %s: %s => %s", c.Op.symbol(), c.Path, c.format(c.From), c.format(c.To))
+}
+
+// format returns the JSON form of a value of the change.
+func (c *Change) format(v interface{}) string {
+	key := c.Path
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		key = key[i+1:]
+	}
+	if redact.IsSensitiveKey(key) {
+		return redact.Placeholder
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(redact.JSON(b))
+}
+
+// Changes is a list of changes.
+type Changes []*Change
+
+// String returns the changes in a human-readable form, one per line, e.g.
+// for a "plan" output.
+func (cs Changes) String() string {
+	lines := make([]string, len(cs))
+	for i, c := range cs {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,382 @@
+// Package diff computes minimal updates between the current and the desired
+// state of resources, e.g. an *aws.Group, an *ocean/aws.Cluster or a
+// *multai.LoadBalancer:
+//
+//	out, err := svc.Read(ctx, &aws.ReadGroupInput{GroupID: spotinst.String(id)})
+//	if err != nil {
+//		return err
+//	}
+//
+//	update, changes, err := diff.Diff(out.Group, desired)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(changes)
+//
+//	if len(changes) > 0 {
+//		_, err = svc.Update(ctx, &aws.UpdateGroupInput{Group: update.(*aws.Group)})
+//	}
+//
+// Only the fields set in the desired state are compared, as the API sets
+// defaults for many of the fields a spec omits. To remove a field, set it to
+// nil explicitly, e.g. desired.SetDescription(nil). The update holds the fields
+// that changed only, with explicit nulls for the removed fields, so that it can
+// be sent as is.
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/jsonutil"
+)
+
+// Diff returns the minimal update turning current into desired, which must be
+// pointers to the same model type, along with the list of changes. A nil
+// current yields an update adding every field set in desired.
+//
+// Fields that are not set in desired are left as they are, as are fields of
+// current that desired does not set in nested objects and in the elements of
+// slices. Fields explicitly set to nil in desired, i.e. listed in its
+// nullFields, are removed, i.e. set to null in the update. The read-only fields
+// of the resource (see jsonutil.RegisterReadOnlyFields) are ignored, except for
+// its ID, which is set on the update if there are changes, so that it can be
+// passed to the Update method of the resource's service. The fields of nested
+// objects are all compared, as their IDs, e.g. those of launch specs, are
+// not read-only. Slices and maps that differ are replaced as a whole, as the
+// API does.
+//
+// The update is a new object of the same type as desired, which may share
+// values with desired and is empty if there are no changes.
+func Diff(current, desired interface{}) (interface{}, Changes, error) {
+	dv := reflect.ValueOf(desired)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return nil, nil, errors.New("diff: desired must be a non-nil pointer to a struct")
+	}
+
+	cur := reflect.Zero(dv.Elem().Type())
+	if current != nil {
+		cv := reflect.ValueOf(current)
+		if cv.Type() != dv.Type() {
+			return nil, nil, fmt.Errorf("diff: mismatched types: %v and %v", cv.Type(), dv.Type())
+		}
+		if !cv.IsNil() {
+			cur = cv.Elem()
+		}
+	}
+
+	update := reflect.New(dv.Elem().Type())
+	d := new(differ)
+	if d.diffStruct(update.Elem(), cur, dv.Elem(), "") {
+		setID(update.Elem(), cur, dv.Elem())
+	}
+
+	return update.Interface(), d.changes, nil
+}
+
+type differ struct {
+	changes Changes
+}
+
+func (d *differ) record(op Op, path string, from, to reflect.Value) {
+	c := &Change{Op: op, Path: path}
+	if from.IsValid() {
+		c.From = value(from)
+	}
+	if to.IsValid() {
+		c.To = value(to)
+	}
+	d.changes = append(d.changes, c)
+}
+
+// diffStruct sets the fields of update that desired sets, or explicitly
+// nulls, and that differ from current, which is a value of the same model
+// type, and reports whether any did.
+func (d *differ) diffStruct(update, current, desired reflect.Value, path string) bool {
+	var changed bool
+
+	nulls := nullFields(desired)
+	t := desired.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		// Only the read-only fields of the resource itself are ignored.
+		name := jsonName(sf)
+		if name == "" || (path == "" && jsonutil.IsReadOnlyField(name)) {
+			continue
+		}
+		p := joinPath(path, name)
+
+		uf, cf, df := update.Field(i), current.Field(i), desired.Field(i)
+
+		if isNil(df) {
+			if !nulls[sf.Name] || isNil(cf) {
+				continue
+			}
+			m := update.Addr().Interface().(model)
+			m.SetNullFields(append(m.NullFields(), sf.Name))
+			d.record(OpRemove, p, cf, reflect.Value{})
+			changed = true
+			continue
+		}
+
+		if isModel(sf.Type) {
+			cur := reflect.Zero(sf.Type.Elem())
+			if !cf.IsNil() {
+				cur = cf.Elem()
+			}
+
+			n := len(d.changes)
+			nested := reflect.New(sf.Type.Elem())
+			if d.diffStruct(nested.Elem(), cur, df.Elem(), p) || cf.IsNil() {
+				if len(d.changes) == n {
+					// An empty object was added.
+					d.record(OpAdd, p, reflect.Value{}, df)
+				}
+				uf.Set(nested)
+				changed = true
+			}
+			continue
+		}
+
+		if covers(cf, df) {
+			continue
+		}
+		changed = true
+
+		uf.Set(copyValue(df))
+		forceSendIfEmpty(update, sf.Name, df)
+		if isNil(cf) {
+			d.record(OpAdd, p, reflect.Value{}, df)
+		} else {
+			d.record(OpUpdate, p, cf, df)
+		}
+	}
+
+	return changed
+}
+
+// setID sets the ID of the resource on the update, from desired or current.
+func setID(update, current, desired reflect.Value) {
+	t := desired.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if sf := t.Field(i); sf.PkgPath == "" && jsonName(sf) == "id" {
+			switch {
+			case !isNil(desired.Field(i)):
+				update.Field(i).Set(desired.Field(i))
+			case !isNil(current.Field(i)):
+				update.Field(i).Set(current.Field(i))
+			}
+			return
+		}
+	}
+}
+
+// forceSendIfEmpty adds the field to the forceSendFields of v, an addressable
+// model, if its value is empty, e.g. an empty slice, so that it is sent.
+func forceSendIfEmpty(v reflect.Value, field string, fv reflect.Value) {
+	switch fv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		if fv.Len() == 0 {
+			m := v.Addr().Interface().(model)
+			m.SetForceSendFields(append(m.ForceSendFields(), field))
+		}
+	}
+}
+
+// A model is a pointer to a struct of a service, e.g. an *aws.Group, whose
+// fields can be explicitly sent as null or as empty values. Its accessors are
+// generated by internal/deepcopygen.
+type model interface {
+	NullFields() []string
+	SetNullFields([]string)
+	ForceSendFields() []string
+	SetForceSendFields([]string)
+}
+
+var modelType = reflect.TypeOf((*model)(nil)).Elem()
+
+// isModel reports whether t is a pointer to a model.
+func isModel(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && t.Implements(modelType)
+}
+
+// nullFields returns the names of the fields explicitly set to nil in v, an
+// addressable struct, if it is a model.
+func nullFields(v reflect.Value) map[string]bool {
+	m, ok := v.Addr().Interface().(model)
+	if !ok {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for _, name := range m.NullFields() {
+		names[name] = true
+	}
+	return names
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// covers reports whether current holds the values set in desired, and none
+// of the fields it explicitly nulls, ignoring the fields of models that
+// desired does not set, e.g. the defaults set by the API.
+func covers(current, desired reflect.Value) bool {
+	t := desired.Type()
+	switch {
+	case isModel(t):
+		if desired.IsNil() || current.IsNil() {
+			return desired.IsNil() == current.IsNil()
+		}
+		return coversStruct(current.Elem(), desired.Elem())
+	case t.Kind() == reflect.Slice && isModel(t.Elem()):
+		if current.IsNil() != desired.IsNil() || current.Len() != desired.Len() {
+			return false
+		}
+		for i := 0; i < desired.Len(); i++ {
+			if !covers(current.Index(i), desired.Index(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return equal(current, desired)
+}
+
+func coversStruct(current, desired reflect.Value) bool {
+	nulls := nullFields(desired)
+	t := desired.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" || jsonName(sf) == "" {
+			continue
+		}
+
+		cf, df := current.Field(i), desired.Field(i)
+		if isNil(df) {
+			if nulls[sf.Name] && !isNil(cf) {
+				return false
+			}
+			continue
+		}
+		if !covers(cf, df) {
+			return false
+		}
+	}
+	return true
+}
+
+// equal reports whether a and b are deeply equal, ignoring unexported fields
+// such as forceSendFields, except for structs with no exported fields, e.g.
+// time.Time, which are compared as a whole.
+func equal(a, b reflect.Value) bool {
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return equal(a.Elem(), b.Elem())
+	case reflect.Struct:
+		var exported bool
+		for i := 0; i < a.NumField(); i++ {
+			if a.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			exported = true
+			if !equal(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		if !exported {
+			return reflect.DeepEqual(a.Interface(), b.Interface())
+		}
+		return true
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		for _, k := range a.MapKeys() {
+			bv := b.MapIndex(k)
+			if !bv.IsValid() || !equal(a.MapIndex(k), bv) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// copyValue returns a copy of v, which is shallow for slices and maps, so that
+// the update does not share pointers to basic values with desired.
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.Elem().Kind() != reflect.Struct {
+			p := reflect.New(v.Type().Elem())
+			p.Elem().Set(v.Elem())
+			return p
+		}
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(s, v)
+		return s
+	case reflect.Map:
+		m := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			m.SetMapIndex(k, v.MapIndex(k))
+		}
+		return m
+	}
+	return v
+}
+
+// value returns the value of a change, dereferencing pointers to basic types.
+func value(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() != reflect.Struct {
+		return v.Elem().Interface()
+	}
+	return v.Interface()
+}
+
+// jsonName returns the JSON name of the given field, or an empty string if
+// the field is not encoded.
+func jsonName(sf reflect.StructField) string {
+	tag := sf.Tag.Get("json")
+	if tag == "" || tag == "-" {
+		return ""
+	}
+	return strings.Split(tag, ",")[0]
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,218 @@
+package diff_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/diff"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const currentGroup = `{
+	"id": "sig-1",
+	"name": "foo",
+	"description": "bar",
+	"createdAt": "2020-01-01T00:00:00Z",
+	"capacity": {"minimum": 1, "maximum": 3, "target": 2},
+	"compute": {
+		"product": "Linux/UNIX",
+		"availabilityZones": [{"name": "us-west-2a"}],
+		"launchSpecification": {
+			"imageId": "ami-1",
+			"securityGroupIds": ["sg-1"],
+			"keyPair": "key"
+		}
+	}
+}`
+
+func decode(t *testing.T, in string, v interface{}) {
+	if err := json.Unmarshal([]byte(in), v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiffGroup(t *testing.T) {
+	current := new(aws.Group)
+	decode(t, currentGroup, current)
+
+	desired := new(aws.Group)
+	decode(t, `{
+		"name": "foo",
+		"capacity": {"minimum": 1, "maximum": 5, "target": 2},
+		"compute": {
+			"product": "Linux/UNIX",
+			"availabilityZones": [{"name": "us-west-2a"}, {"name": "us-west-2b"}],
+			"launchSpecification": {
+				"imageId": "ami-2",
+				"securityGroupIds": [],
+				"keyPair": "key",
+				"userData": "c2VjcmV0"
+			}
+		}
+	}`, desired)
+	desired.SetDescription(nil)
+
+	update, changes, err := diff.Diff(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"id": "sig-1",
+		"description": null,
+		"capacity": {"maximum": 5},
+		"compute": {
+			"availabilityZones": [{"name": "us-west-2a"}, {"name": "us-west-2b"}],
+			"launchSpecification": {
+				"imageId": "ami-2",
+				"securityGroupIds": [],
+				"userData": "c2VjcmV0"
+			}
+		}
+	}`, string(b))
+
+	assert.Equal(t, `- description: "bar"
+~ capacity.maximum: 3 => 5
+~ compute.launchSpecification.securityGroupIds: ["sg-1"] => []
+~ compute.launchSpecification.imageId: "ami-1" => "ami-2"
++ compute.launchSpecification.userData: "c2VjcmV0"
+~ compute.availabilityZones: [{"name":"us-west-2a"}] => [{"name":"us-west-2a"},{"name":"us-west-2b"}]`, changes.String())
+
+	// No changes.
+	update, changes, err = diff.Diff(current, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, changes)
+	b, _ = json.Marshal(update)
+	assert.JSONEq(t, `{}`, string(b))
+}
+
+func TestDiffNestedReadOnlyNames(t *testing.T) {
+	// Read-only names only apply to the fields of the resource itself.
+	jsonutil.RegisterReadOnlyFields("product")
+
+	current := new(aws.Group)
+	decode(t, currentGroup, current)
+
+	desired := new(aws.Group)
+	decode(t, `{"compute": {"product": "Windows"}}`, desired)
+
+	update, changes, err := diff.Diff(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(update)
+	assert.JSONEq(t, `{"id": "sig-1", "compute": {"product": "Windows"}}`, string(b))
+	assert.Equal(t, `~ compute.product: "Linux/UNIX" => "Windows"`, changes.String())
+}
+
+func TestDiffServerDefaults(t *testing.T) {
+	// The API fills in defaults for the fields that the spec omits.
+	current := new(aws.Group)
+	decode(t, `{
+		"id": "sig-1",
+		"name": "foo",
+		"description": "bar",
+		"capacity": {"minimum": 1, "maximum": 3, "target": 2, "unit": "instance"},
+		"strategy": {"risk": 100, "fallbackToOd": true},
+		"compute": {
+			"product": "Linux/UNIX",
+			"availabilityZones": [{"name": "us-west-2a", "subnetIds": ["subnet-1"]}],
+			"launchSpecification": {
+				"imageId": "ami-1",
+				"keyPair": "key",
+				"healthCheckType": "EC2",
+				"monitoring": false,
+				"ebsOptimized": false
+			}
+		}
+	}`, current)
+
+	desired := new(aws.Group)
+	decode(t, `{
+		"name": "foo",
+		"capacity": {"minimum": 1, "maximum": 3, "target": 2},
+		"compute": {
+			"product": "Linux/UNIX",
+			"availabilityZones": [{"name": "us-west-2a"}],
+			"launchSpecification": {"imageId": "ami-1", "keyPair": "key"}
+		}
+	}`, desired)
+
+	update, changes, err := diff.Diff(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, changes)
+	b, _ := json.Marshal(update)
+	assert.JSONEq(t, `{}`, string(b))
+
+	// Explicit nulls remove the defaults.
+	desired.Strategy = new(aws.Strategy)
+	desired.Strategy.SetRisk(nil)
+	desired.Compute.LaunchSpecification.SetHealthCheckType(nil)
+
+	update, changes, err = diff.Diff(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ = json.Marshal(update)
+	assert.JSONEq(t, `{
+		"id": "sig-1",
+		"strategy": {"risk": null},
+		"compute": {"launchSpecification": {"healthCheckType": null}}
+	}`, string(b))
+	assert.Equal(t, `- compute.launchSpecification.healthCheckType: "EC2"
+- strategy.risk: 100`, changes.String())
+}
+
+func TestDiffCreate(t *testing.T) {
+	desired := new(multai.LoadBalancer)
+	decode(t, `{"name": "lb", "tags": [{"key": "k", "value": "v"}]}`, desired)
+
+	update, changes, err := diff.Diff(nil, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, _ := json.Marshal(update)
+	assert.JSONEq(t, `{"name": "lb", "tags": [{"key": "k", "value": "v"}]}`, string(b))
+	if assert.Len(t, changes, 2) {
+		assert.Equal(t, diff.OpAdd, changes[0].Op)
+		assert.Equal(t, "name", changes[0].Path)
+		assert.Equal(t, "lb", changes[0].To)
+	}
+
+	_, _, err = diff.Diff(new(aws.Group), desired)
+	assert.Error(t, err)
+}
+
+func TestChangeRedaction(t *testing.T) {
+	c := &diff.Change{Op: diff.OpUpdate, Path: "thirdPartiesIntegration.rancher.secretKey", From: "a", To: "b"}
+	assert.Equal(t, "~ thirdPartiesIntegration.rancher.secretKey: [REDACTED] => [REDACTED]", c.String())
+
+	c = &diff.Change{Op: diff.OpAdd, Path: "thirdPartiesIntegration.rancher", To: map[string]string{"secretKey": "a"}}
+	assert.Equal(t, `+ thirdPartiesIntegration.rancher: {"secretKey":"[REDACTED]"}`, c.String())
+}
+
+func TestChangesUnder(t *testing.T) {
+	changes := diff.Changes{
+		{Op: diff.OpUpdate, Path: "capacity.target"},
+		{Op: diff.OpAdd, Path: "compute.launchSpecificationX"},
+	}
+	assert.False(t, changes.Under("compute.launchSpecification"))
+
+	changes = append(changes, &diff.Change{Op: diff.OpUpdate, Path: "compute.launchSpecification.imageId"})
+	assert.True(t, changes.Under("compute.launchSpecification"))
+	assert.True(t, changes.Under("capacity"))
+	assert.True(t, changes.Under("capacity.target"))
+	assert.False(t, changes.Under("capacity.tar"))
+}
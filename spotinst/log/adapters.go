@@ -0,0 +1,166 @@
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NewStdLogger returns a LeveledLogger that formats messages and their fields
+// on a single line and writes them to the given Logger (e.g. a stdlib
+// *log.Logger). Messages below the given level are discarded.
+//
+//	[INFO] spotinst: request sent method=GET status=200
+func NewStdLogger(logger Logger, level Level) LeveledLogger {
+	return &stdLogger{logger: logger, level: level}
+}
+
+type stdLogger struct {
+	logger Logger
+	level  Level
+}
+
+func (l *stdLogger) Printf(format string, args ...interface{}) {
+	l.logger.Printf(format, args...)
+}
+
+func (l *stdLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log(LevelDebug, msg, keysAndValues)
+}
+
+func (l *stdLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log(LevelInfo, msg, keysAndValues)
+}
+
+func (l *stdLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log(LevelWarn, msg, keysAndValues)
+}
+
+func (l *stdLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log(LevelError, msg, keysAndValues)
+}
+
+func (l *stdLogger) log(level Level, msg string, keysAndValues []interface{}) {
+	if level < l.level {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", level, msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+		val := "<missing>"
+		if i+1 < len(keysAndValues) {
+			val = formatValue(keysAndValues[i+1])
+		}
+		fmt.Fprintf(&b, " %s=%s", key, val)
+	}
+
+	l.logger.Printf("%s", b.String())
+}
+
+// formatValue formats a field value, quoting it if it contains whitespace or
+// quotes so that the output remains parsable.
+func formatValue(v interface{}) string {
+	var s string
+	switch t := v.(type) {
+	case string:
+		s = t
+	case error:
+		s = t.Error()
+	case fmt.Stringer:
+		s = t.String()
+	default:
+		s = fmt.Sprint(v)
+	}
+	if s == "" || strings.ContainsAny(s, " \t\r\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// SugaredLogger specifies the interface of structured loggers that accept
+// alternating keys and values, such as *zap.SugaredLogger.
+type SugaredLogger interface {
+	Debugw(msg string, keysAndValues ...interface{})
+	Infow(msg string, keysAndValues ...interface{})
+	Warnw(msg string, keysAndValues ...interface{})
+	Errorw(msg string, keysAndValues ...interface{})
+}
+
+// FromSugaredLogger returns a LeveledLogger that writes to the given
+// SugaredLogger, e.g. FromSugaredLogger(zapLogger.Sugar()).
+func FromSugaredLogger(logger SugaredLogger) LeveledLogger {
+	return &sugaredLogger{logger}
+}
+
+type sugaredLogger struct {
+	logger SugaredLogger
+}
+
+func (l *sugaredLogger) Printf(format string, args ...interface{}) {
+	l.logger.Infow(fmt.Sprintf(format, args...))
+}
+
+func (l *sugaredLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debugw(msg, keysAndValues...)
+}
+
+func (l *sugaredLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Infow(msg, keysAndValues...)
+}
+
+func (l *sugaredLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warnw(msg, keysAndValues...)
+}
+
+func (l *sugaredLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Errorw(msg, keysAndValues...)
+}
+
+// KeyValueLogger specifies the interface of loggers that accept a flat list of
+// alternating keys and values, such as go-kit's log.Logger.
+type KeyValueLogger interface {
+	Log(keyvals ...interface{}) error
+}
+
+// FromKeyValueLogger returns a LeveledLogger that writes to the given
+// KeyValueLogger. The level and message are passed as the "level" and "msg"
+// keys respectively.
+func FromKeyValueLogger(logger KeyValueLogger) LeveledLogger {
+	return LeveledFunc(func(level Level, msg string, keysAndValues ...interface{}) {
+		kv := make([]interface{}, 0, len(keysAndValues)+4)
+		kv = append(kv, "level", strings.ToLower(level.String()), "msg", msg)
+		logger.Log(append(kv, keysAndValues...)...)
+	})
+}
+
+// The LeveledFunc type is an adapter to allow the use of ordinary functions as
+// LeveledLogger, e.g. to bridge loggers such as logrus or logr. Printf calls
+// are logged at LevelInfo.
+type LeveledFunc func(level Level, msg string, keysAndValues ...interface{})
+
+// Printf calls f(LevelInfo, fmt.Sprintf(format, args...)).
+func (f LeveledFunc) Printf(format string, args ...interface{}) {
+	f(LevelInfo, fmt.Sprintf(format, args...))
+}
+
+// Debug calls f(LevelDebug, msg, keysAndValues...).
+func (f LeveledFunc) Debug(msg string, keysAndValues ...interface{}) {
+	f(LevelDebug, msg, keysAndValues...)
+}
+
+// Info calls f(LevelInfo, msg, keysAndValues...).
+func (f LeveledFunc) Info(msg string, keysAndValues ...interface{}) {
+	f(LevelInfo, msg, keysAndValues...)
+}
+
+// Warn calls f(LevelWarn, msg, keysAndValues...).
+func (f LeveledFunc) Warn(msg string, keysAndValues ...interface{}) {
+	f(LevelWarn, msg, keysAndValues...)
+}
+
+// Error calls f(LevelError, msg, keysAndValues...).
+func (f LeveledFunc) Error(msg string, keysAndValues ...interface{}) {
+	f(LevelError, msg, keysAndValues...)
+}
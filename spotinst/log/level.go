@@ -0,0 +1,52 @@
+package log
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Level is a logging priority. Higher levels are more important.
+type Level int
+
+const (
+	// LevelDebug logs are typically voluminous, e.g. full request and response
+	// details.
+	LevelDebug Level = iota
+
+	// LevelInfo is the default logging priority.
+	LevelInfo
+
+	// LevelWarn logs are more important than Info, but don't need individual
+	// human review, e.g. retried requests.
+	LevelWarn
+
+	// LevelError logs are high-priority, e.g. requests that could not be sent.
+	LevelError
+)
+
+var levelName = map[Level]string{
+	LevelDebug: "DEBUG",
+	LevelInfo:  "INFO",
+	LevelWarn:  "WARN",
+	LevelError: "ERROR",
+}
+
+func (l Level) String() string {
+	if name, ok := levelName[l]; ok {
+		return name
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(l))
+}
+
+// ParseLevel parses a level name (e.g. "debug", "INFO"), case-insensitively.
+func ParseLevel(s string) (Level, error) {
+	for l, name := range levelName {
+		if strings.EqualFold(s, name) {
+			return l, nil
+		}
+	}
+	if strings.EqualFold(s, "warning") {
+		return LevelWarn, nil
+	}
+	return LevelDebug, fmt.Errorf("spotinst: unknown log level %q", s)
+}
@@ -23,3 +23,30 @@ type LoggerFunc func(format string, args ...interface{})
 func (f LoggerFunc) Printf(format string, args ...interface{}) {
 	f(format, args...)
 }
+
+// LeveledLogger specifies the interface for leveled, structured log operations.
+// Each method accepts a message and an optional list of alternating keys and
+// values, e.g. Info("request sent", "method", "GET", "status", 200).
+//
+// A LeveledLogger also implements Logger, so it can be used anywhere a Logger
+// is expected, such as spotinst.Config.Logger.
+type LeveledLogger interface {
+	Logger
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// Leveled returns a LeveledLogger for the given logger. If the logger already
+// implements LeveledLogger it is returned as is, otherwise it is wrapped with
+// NewStdLogger at LevelDebug. A nil logger yields nil.
+func Leveled(logger Logger) LeveledLogger {
+	if logger == nil {
+		return nil
+	}
+	if l, ok := logger.(LeveledLogger); ok {
+		return l
+	}
+	return NewStdLogger(logger, LevelDebug)
+}
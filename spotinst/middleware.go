@@ -0,0 +1,33 @@
+package spotinst
+
+import "net/http"
+
+// A Handler sends an HTTP request and returns an HTTP response. The request's
+// context controls its lifetime.
+type Handler interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// The HandlerFunc type is an adapter to allow the use of ordinary functions as
+// Handler. If f is a function with the appropriate signature, HandlerFunc(f) is
+// a Handler that calls f.
+type HandlerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f HandlerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// A Middleware wraps a Handler to inspect or modify requests before they are
+// sent, and responses before they are returned to the caller. Middlewares may
+// also short-circuit the chain by returning without calling next.
+type Middleware func(next Handler) Handler
+
+// Chain returns a Handler that runs the given middlewares around h. The first
+// middleware is the outermost one.
+func Chain(h Handler, mws ...Middleware) Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
@@ -0,0 +1,127 @@
+// Package mock provides the call recording and canned results shared by the
+// generated service mocks (see the mocks subpackage of each service).
+package mock
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Call is a recorded call to a mocked method.
+type Call struct {
+	// The name of the method.
+	Method string
+
+	// The arguments the method was called with.
+	Args []interface{}
+}
+
+// Mock records calls and holds canned results. It is embedded by the
+// generated mocks, and its zero value is ready to use.
+type Mock struct {
+	mu      sync.Mutex
+	calls   []Call
+	results map[string][][]interface{}
+}
+
+// Record records a call to the given method.
+func (m *Mock) Record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls, in order. If methods are given, only the
+// calls to these methods are returned.
+func (m *Mock) Calls(methods ...string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var calls []Call
+	for _, call := range m.calls {
+		if len(methods) == 0 || contains(methods, call.Method) {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Called reports how many times the given method was called.
+func (m *Mock) Called(method string) int {
+	return len(m.Calls(method))
+}
+
+// Returns registers the values returned by the given method on every
+// subsequent call, unless the method's function override is set. The values
+// must have the method's result types, in order, e.g.:
+//
+//	m.Returns("Read", &aws.ReadGroupOutput{Group: group}, nil)
+func (m *Mock) Returns(method string, values ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.results == nil {
+		m.results = make(map[string][][]interface{})
+	}
+
+	m.results[method] = [][]interface{}{values}
+}
+
+// ReturnsOnce queues values returned by the given method on a single call.
+// Queued values are consumed in order, and the last ones are kept for all
+// subsequent calls.
+func (m *Mock) ReturnsOnce(method string, values ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.results == nil {
+		m.results = make(map[string][][]interface{})
+	}
+
+	m.results[method] = append(m.results[method], values)
+}
+
+// Result returns the canned values of the given method for the current call,
+// and whether there are any. It panics if the number of canned values does
+// not match the method's number of results n.
+func (m *Mock) Result(method string, n int) ([]interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.results[method]
+	if len(queue) == 0 {
+		return nil, false
+	}
+
+	values := queue[0]
+	if len(queue) > 1 {
+		m.results[method] = queue[1:]
+	}
+
+	if len(values) != n {
+		panic(fmt.Sprintf("mock: %s returns %d values, got %d canned values",
+			method, n, len(values)))
+	}
+
+	return values, true
+}
+
+// Reset removes the recorded calls and canned values.
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+	m.results = nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
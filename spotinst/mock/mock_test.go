@@ -0,0 +1,66 @@
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws/mocks"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMock(t *testing.T) {
+	ctx := context.Background()
+	input := &aws.ReadGroupInput{GroupID: spotinst.String("sig-1")}
+
+	m := new(mocks.Service)
+	var svc aws.Service = m
+
+	// Unconfigured methods return new outputs.
+	out, err := svc.Read(ctx, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, out)
+	assert.Nil(t, out.Group)
+
+	// Canned values.
+	errNotFound := errors.New("not found")
+	group := &aws.Group{ID: spotinst.String("sig-1")}
+	m.ReturnsOnce("Read", nil, errNotFound)
+	m.ReturnsOnce("Read", &aws.ReadGroupOutput{Group: group}, nil)
+
+	_, err = svc.Read(ctx, input)
+	assert.Equal(t, errNotFound, err)
+
+	for i := 0; i < 2; i++ {
+		out, err = svc.Read(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, group, out.Group)
+	}
+
+	// Function overrides take precedence.
+	m.ReadFunc = func(ctx context.Context, in *aws.ReadGroupInput) (*aws.ReadGroupOutput, error) {
+		return nil, errNotFound
+	}
+	_, err = svc.Read(ctx, input)
+	assert.Equal(t, errNotFound, err)
+
+	// Recorded calls.
+	svc.List(ctx, &aws.ListGroupsInput{})
+	assert.Equal(t, 5, m.Called("Read"))
+	assert.Len(t, m.Calls(), 6)
+	assert.Equal(t, []interface{}{ctx, input}, m.Calls("Read")[0].Args)
+
+	m.Reset()
+	assert.Empty(t, m.Calls())
+
+	// Mismatched canned values.
+	m.Returns("List", nil)
+	assert.Panics(t, func() { svc.List(ctx, &aws.ListGroupsInput{}) })
+
+	m.Reset()
+	m.Returns("List", &aws.ReadGroupOutput{}, nil)
+	assert.PanicsWithValue(t, "mock: List returns *aws.ListGroupsOutput as result 0, got canned value of type *aws.ReadGroupOutput",
+		func() { svc.List(ctx, &aws.ListGroupsInput{}) })
+}
@@ -0,0 +1,170 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Limiter controls how frequently requests are allowed to be sent to the
+// Spotinst API. It holds a default token bucket, applied to every request, and
+// optional token buckets applied to requests whose path matches a prefix (e.g.
+// "/aws/ec2/group" or "/ocean/aws/k8s"). When multiple prefixes match, the
+// longest one wins.
+//
+// A Limiter is safe to use across multiple goroutines, and is typically shared
+// by every service client created from the same session.Session.
+type Limiter struct {
+	def      *Bucket
+	mu       sync.RWMutex
+	prefixes []*prefixBucket
+}
+
+type prefixBucket struct {
+	prefix string
+	bucket *Bucket
+}
+
+// New returns a new Limiter allowing up to rps requests per second on average,
+// with bursts of up to burst requests. A non-positive rps means that requests
+// are unlimited unless they match a prefix added by WithPrefix.
+func New(rps float64, burst int) *Limiter {
+	l := new(Limiter)
+	if rps > 0 {
+		l.def = NewBucket(rps, burst)
+	}
+	return l
+}
+
+// WithPrefix defines a dedicated limit for requests whose path starts with
+// the given prefix. Requests matching a prefix are limited by the prefix's
+// bucket instead of the default one.
+func (l *Limiter) WithPrefix(prefix string, rps float64, burst int) *Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prefixes = append(l.prefixes, &prefixBucket{
+		prefix: prefix,
+		bucket: NewBucket(rps, burst),
+	})
+
+	// Keep the longest prefixes first.
+	sort.SliceStable(l.prefixes, func(i, j int) bool {
+		return len(l.prefixes[i].prefix) > len(l.prefixes[j].prefix)
+	})
+
+	return l
+}
+
+// Wait blocks until a request to the given path is allowed to be sent, or the
+// context is done.
+func (l *Limiter) Wait(ctx context.Context, path string) error {
+	if l == nil {
+		return nil
+	}
+	if b := l.bucket(path); b != nil {
+		return b.Wait(ctx)
+	}
+	return nil
+}
+
+func (l *Limiter) bucket(path string) *Bucket {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, p := range l.prefixes {
+		if strings.HasPrefix(path, p.prefix) {
+			return p.bucket
+		}
+	}
+
+	return l.def
+}
+
+// A Bucket is a token bucket refilled at a constant rate.
+type Bucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewBucket returns a new full token bucket refilled at rps tokens per second,
+// holding up to burst tokens. A burst lower than 1 is treated as 1.
+func NewBucket(rps float64, burst int) *Bucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Bucket{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// Wait blocks until a token is available, or the context is done. A canceled
+// wait does not consume a token.
+func (b *Bucket) Wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before the
+// token becomes valid. The balance may go negative, so that concurrent waiters
+// are served in order.
+func (b *Bucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance()
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	if b.rate <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a previously reserved token.
+func (b *Bucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance()
+	if b.tokens++; b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *Bucket) advance() {
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketReserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewBucket(2, 2)
+	b.now = func() time.Time { return now }
+
+	// Burst.
+	assert.Equal(t, time.Duration(0), b.reserve())
+	assert.Equal(t, time.Duration(0), b.reserve())
+
+	// Exhausted; waiters are queued.
+	assert.Equal(t, 500*time.Millisecond, b.reserve())
+	assert.Equal(t, time.Second, b.reserve())
+
+	// Refilled.
+	now = now.Add(3 * time.Second)
+	assert.Equal(t, time.Duration(0), b.reserve())
+}
+
+func TestBucketWaitCanceled(t *testing.T) {
+	b := NewBucket(0.001, 1)
+	assert.NoError(t, b.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, b.Wait(ctx))
+}
+
+func TestLimiterPrefix(t *testing.T) {
+	l := New(0, 0).
+		WithPrefix("/aws/ec2", 1, 1).
+		WithPrefix("/aws/ec2/group", 1, 1)
+
+	assert.Nil(t, l.bucket("/ocean/aws/k8s/cluster"))
+	assert.Equal(t, l.prefixes[0].bucket, l.bucket("/aws/ec2/group/sig-1"))
+	assert.Equal(t, l.prefixes[1].bucket, l.bucket("/aws/ec2/instanceType"))
+
+	var nilLimiter *Limiter
+	assert.NoError(t, nilLimiter.Wait(context.Background(), "/"))
+}
@@ -0,0 +1,93 @@
+package recorder
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// CassetteVersion is the version of the cassette format.
+const CassetteVersion = 1
+
+// A Cassette holds recorded interactions.
+type Cassette struct {
+	// The version of the cassette format.
+	Version int `json:"version" yaml:"version"`
+
+	// The recorded interactions, in order.
+	Interactions []*Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// An Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  *Request  `json:"request" yaml:"request"`
+	Response *Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string              `json:"method" yaml:"method"`
+	URL    string              `json:"url" yaml:"url"`
+	Header map[string][]string `json:"header,omitempty" yaml:"header,omitempty"`
+	Body   string              `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int                 `json:"statusCode" yaml:"statusCode"`
+	Header     map[string][]string `json:"header,omitempty" yaml:"header,omitempty"`
+	Body       string              `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette from the given file. Files with a .yaml or
+// .yml extension are decoded as YAML, and any other as JSON.
+func LoadCassette(filename string) (*Cassette, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	c := new(Cassette)
+	if isYAML(filename) {
+		err = yaml.Unmarshal(b, c)
+	} else {
+		err = json.Unmarshal(b, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to the given file, creating its directory if
+// needed. The format is chosen by extension, as in LoadCassette.
+func (c *Cassette) Save(filename string) error {
+	var (
+		b   []byte
+		err error
+	)
+	if isYAML(filename) {
+		b, err = yaml.Marshal(c)
+	} else {
+		b, err = json.MarshalIndent(c, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, b, 0644)
+}
+
+func isYAML(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	return ext == ".yaml" || ext == ".yml"
+}
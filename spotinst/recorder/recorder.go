@@ -0,0 +1,330 @@
+// Package recorder provides an http.RoundTripper that records the traffic of
+// the SDK into cassettes, and replays it offline, e.g. in CI:
+//
+//	rec, err := recorder.New("testdata/groups.yaml", recorder.ModeAuto)
+//	if err != nil {
+//		return err
+//	}
+//	defer rec.Stop()
+//
+//	sess := session.New(spotinst.DefaultConfig().WithHTTPClient(rec.HTTPClient()))
+//
+// Cassettes are sanitized before being saved: tokens, the accountId parameter
+// and the values of sensitive JSON fields (see package redact) are redacted.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"sync"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/redact"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of an existing cassette, and fails
+	// requests that match none of them.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and records the interactions into
+	// a new cassette, overwriting any existing one.
+	ModeRecord
+
+	// ModeAuto replays the cassette if it exists, or records it otherwise.
+	ModeAuto
+)
+
+// ErrNoMatch is returned in replay mode for requests that match none of the
+// interactions left in the cassette.
+var ErrNoMatch = errors.New("recorder: no recorded interaction matches request")
+
+// accountIDParam is the name of the query parameter (and JSON field) carrying
+// the account ID, which is redacted from cassettes.
+const accountIDParam = "accountId"
+
+// accountIDRegexp matches the accountId parameter in URLs embedded in bodies,
+// e.g. the request URL echoed by the API.
+var accountIDRegexp = regexp.MustCompile(accountIDParam + `=[^&"\s]+`)
+
+// A Recorder is an http.RoundTripper that records or replays interactions.
+// Replayed interactions are consumed in order, so that repeated requests
+// (e.g. polls) get the successive recorded responses.
+type Recorder struct {
+	// Transport sends requests in record mode. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	filename string
+	mode     Mode
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New returns a Recorder for the cassette stored in the given file, which is
+// loaded in replay mode. Call Stop to save the recorded interactions.
+func New(filename string, mode Mode) (*Recorder, error) {
+	if mode == ModeAuto {
+		mode = ModeRecord
+		if _, err := os.Stat(filename); err == nil {
+			mode = ModeReplay
+		}
+	}
+
+	r := &Recorder{
+		filename: filename,
+		mode:     mode,
+		cassette: &Cassette{Version: CassetteVersion},
+	}
+
+	if mode == ModeReplay {
+		c, err := LoadCassette(filename)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the recorder. It is never ModeAuto.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// HTTPClient returns an HTTP client using the recorder as its transport, to be
+// set as spotinst.Config.HTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop saves the cassette in record mode. In replay mode, it returns an error
+// if some interactions were not replayed.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeRecord {
+		return r.cassette.Save(r.filename)
+	}
+
+	var unused int
+	for _, used := range r.used {
+		if !used {
+			unused++
+		}
+	}
+	if unused > 0 {
+		return fmt.Errorf("recorder: %d of %d interactions were not replayed from %s",
+			unused, len(r.used), r.filename)
+	}
+
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := sanitizeRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded *Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// The body may change length once sanitized.
+	header := redact.Header(resp.Header)
+	header.Del("Content-Length")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: &Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       sanitizeBody(body),
+		},
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded *Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !match(interaction.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+
+		resp := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+			StatusCode:    resp.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header(resp.Header).Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(resp.Body))),
+			ContentLength: int64(len(resp.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s (cassette: %s)",
+		ErrNoMatch, recorded.Method, recorded.URL, r.filename)
+}
+
+// match reports whether a request matches a recorded one, on method, path,
+// query and normalized JSON body. Both requests must be sanitized.
+func match(recorded, req *Request) bool {
+	if recorded.Method != req.Method {
+		return false
+	}
+
+	u1, err1 := url.Parse(recorded.URL)
+	u2, err2 := url.Parse(req.URL)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	if u1.Path != u2.Path || !reflect.DeepEqual(u1.Query(), u2.Query()) {
+		return false
+	}
+
+	return recorded.Body == req.Body || equalJSON(recorded.Body, req.Body)
+}
+
+func equalJSON(a, b string) bool {
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// requestBody returns the body of req without consuming it.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	return b, nil
+}
+
+// sanitizeRequest returns the recorded form of req, with secrets redacted.
+func sanitizeRequest(req *http.Request, body []byte) *Request {
+	u := *req.URL
+	if q := u.Query(); q.Get(accountIDParam) != "" {
+		q.Set(accountIDParam, redact.Placeholder)
+		u.RawQuery = q.Encode()
+	}
+
+	return &Request{
+		Method: req.Method,
+		URL:    u.String(),
+		Header: redact.Header(req.Header),
+		Body:   sanitizeBody(body),
+	}
+}
+
+// sanitizeBody redacts secrets and account IDs from a JSON body, and returns
+// it compacted. Bodies that are not JSON are returned as is.
+func sanitizeBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return string(body)
+	}
+
+	b, err := json.Marshal(redactAccountID(redact.Value(v)))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(b)
+}
+
+func redactAccountID(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if k == accountIDParam {
+				if _, ok := val.(string); ok {
+					t[k] = redact.Placeholder
+				}
+				continue
+			}
+			t[k] = redactAccountID(val)
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = redactAccountID(val)
+		}
+	case string:
+		return accountIDRegexp.ReplaceAllString(t, accountIDParam+"="+redact.Placeholder)
+	}
+	return v
+}
@@ -0,0 +1,134 @@
+package recorder
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spotinsttest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordReplay(t *testing.T) {
+	for _, name := range []string{"groups.yaml", "groups.json"} {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), name)
+			ctx := context.Background()
+
+			// Record.
+			srv := spotinsttest.NewServer()
+			rec, err := New(filename, ModeAuto)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, ModeRecord, rec.Mode())
+
+			svc := aws.New(session.New(srv.Config().WithHTTPClient(rec.HTTPClient())))
+			created, err := svc.Create(ctx, &aws.CreateGroupInput{Group: &aws.Group{
+				Name: spotinst.String("foo"),
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			id := created.Group.ID
+
+			if _, err := svc.Read(ctx, &aws.ReadGroupInput{GroupID: id}); err != nil {
+				t.Fatal(err)
+			}
+			if err := rec.Stop(); err != nil {
+				t.Fatal(err)
+			}
+			srv.Close()
+
+			b, err := ioutil.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.NotContains(t, string(b), spotinsttest.Token)
+			assert.NotContains(t, string(b), spotinsttest.Account)
+			assert.Contains(t, string(b), "Bearer [REDACTED]")
+
+			// Replay, with the server down.
+			rec, err = New(filename, ModeAuto)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, ModeReplay, rec.Mode())
+
+			svc = aws.New(session.New(srv.Config().WithHTTPClient(rec.HTTPClient())))
+			replayed, err := svc.Create(ctx, &aws.CreateGroupInput{Group: &aws.Group{
+				Name: spotinst.String("foo"),
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, spotinst.StringValue(id), spotinst.StringValue(replayed.Group.ID))
+
+			// Requests that match no interaction fail.
+			_, err = svc.Read(ctx, &aws.ReadGroupInput{GroupID: spotinst.String("sig-other")})
+			assert.True(t, errors.Is(err, ErrNoMatch), "expected no match, got %v", err)
+
+			// Interactions that were not replayed are reported.
+			assert.Error(t, rec.Stop())
+
+			if _, err := svc.Read(ctx, &aws.ReadGroupInput{GroupID: id}); err != nil {
+				t.Fatal(err)
+			}
+			assert.NoError(t, rec.Stop())
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	recorded := &Request{
+		Method: http.MethodPost,
+		URL:    "https://api.spotinst.io/aws/ec2/group?accountId=%5BREDACTED%5D&a=1",
+		Body:   `{"group":{"name":"foo","capacity":{"target":1}}}`,
+	}
+
+	cases := map[string]struct {
+		Request  *Request
+		Expected bool
+	}{
+		"normalized_body": {
+			Request: &Request{
+				Method: http.MethodPost,
+				URL:    "http://127.0.0.1:1234/aws/ec2/group?a=1&accountId=%5BREDACTED%5D",
+				Body:   `{"group": {"capacity": {"target": 1}, "name": "foo"}}`,
+			},
+			Expected: true,
+		},
+		"method": {
+			Request:  &Request{Method: http.MethodPut, URL: recorded.URL, Body: recorded.Body},
+			Expected: false,
+		},
+		"query": {
+			Request: &Request{
+				Method: http.MethodPost,
+				URL:    "https://api.spotinst.io/aws/ec2/group?accountId=%5BREDACTED%5D&a=2",
+				Body:   recorded.Body,
+			},
+			Expected: false,
+		},
+		"body": {
+			Request: &Request{
+				Method: http.MethodPost,
+				URL:    recorded.URL,
+				Body:   `{"group":{"name":"bar","capacity":{"target":1}}}`,
+			},
+			Expected: false,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, match(recorded, c.Request))
+		})
+	}
+}
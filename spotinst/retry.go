@@ -0,0 +1,141 @@
+package spotinst
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRetryMaxAttempts is the default maximum number of attempts
+	// (including the initial one) made for a single request.
+	defaultRetryMaxAttempts = 3
+
+	// defaultRetryBaseDelay is the default delay before the first retry.
+	defaultRetryBaseDelay = 500 * time.Millisecond
+
+	// defaultRetryMaxDelay is the default upper bound of a single delay.
+	defaultRetryMaxDelay = 20 * time.Second
+
+	// defaultRetryJitter is the default fraction of a delay to randomize.
+	defaultRetryJitter = 0.5
+)
+
+// A RetryPolicy defines how the SDK's API clients retry requests that failed
+// with a transient error.
+type RetryPolicy struct {
+	// The maximum number of attempts made for a single request, including the
+	// initial one. A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// The delay before the first retry. Subsequent delays grow exponentially
+	// (BaseDelay * 2^n) up to MaxDelay.
+	BaseDelay time.Duration
+
+	// The upper bound of a single delay, including delays requested by the
+	// API via the Retry-After header.
+	MaxDelay time.Duration
+
+	// The fraction (0.0 to 1.0) of each delay that is randomized to avoid
+	// synchronized retries from multiple clients.
+	Jitter float64
+
+	// The HTTP status codes that are considered transient.
+	RetryableStatusCodes []int
+
+	// States whether network errors (e.g. connection reset, DNS failures)
+	// should be retried.
+	RetryNetworkErrors bool
+
+	// States whether non-idempotent requests, e.g. POST requests creating a
+	// resource or requests scaling a group, should be retried on failures
+	// other than rate limiting (429). Such requests may have been processed
+	// by the API before failing, so retrying them may repeat their effect,
+	// e.g. create a duplicate resource.
+	RetryNonIdempotent bool
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// DefaultRetryPolicy returns the default retry policy. It retries idempotent
+// requests up to 3 times on rate limiting (429), gateway errors (502, 503, 504)
+// and network errors, and non-idempotent ones on rate limiting only.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		BaseDelay:   defaultRetryBaseDelay,
+		MaxDelay:    defaultRetryMaxDelay,
+		Jitter:      defaultRetryJitter,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+	}
+}
+
+// NoRetryPolicy returns a retry policy that never retries.
+func NoRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 1}
+}
+
+// IsRetryableStatus reports whether the given HTTP status code is considered
+// transient by the policy.
+func (p *RetryPolicy) IsRetryableStatus(code int) bool {
+	if p == nil {
+		return false
+	}
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the delay to wait before the given retry attempt (starting
+// at 1), including jitter.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	if p == nil || attempt < 1 {
+		return 0
+	}
+
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	if jitter := p.Jitter; jitter > 0 {
+		if jitter > 1 {
+			jitter = 1
+		}
+		// Randomize the delay within [delay*(1-jitter), delay].
+		delay -= delay * jitter * p.float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// ClampDelay caps the given delay at MaxDelay.
+func (p *RetryPolicy) ClampDelay(delay time.Duration) time.Duration {
+	if p != nil && p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+func (p *RetryPolicy) float64() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.rand == nil {
+		p.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return p.rand.Float64()
+}
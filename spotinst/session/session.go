@@ -2,6 +2,8 @@ package session
 
 import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
 )
 
 // A Session provides a central location to create service clients.
@@ -15,8 +17,92 @@ type Session struct {
 // New creates a new instance of Session. Once the Session is created it
 // can be mutated to modify the Config. The Session is safe to be read
 // concurrently, but it should not be written to concurrently.
+//
+// Service clients created from the same Session share its Config values by
+// reference, e.g. a Config.RateLimiter is shared by all of them.
+//
+// The Config is loaded from the following sources, each taking precedence
+// over the previous ones:
+//
+//  1. The SDK's defaults (see spotinst.DefaultConfig).
+//  2. The profile selected by SPOTINST_PROFILE ("default" by default) in the
+//     shared config file (~/.spotinst/config, or SPOTINST_CONFIG_FILE).
+//  3. The environment: SPOTINST_BASE_URL and SPOTINST_DEBUG.
+//  4. The given configs, in order.
+//
+// If the shared config file or the environment holds invalid settings, New
+// logs the error through the configured logger (or log.DefaultStdLogger) and
+// falls back to the defaults, keeping the safety settings of the file that are
+// valid: dry_run, read_only and validation. If the selected profile cannot be
+// loaded at all, e.g. it does not exist or the file cannot be parsed, its
+// safety settings are unknown, so the session is read-only. New never returns
+// the error: only NewSession and Must report it.
 func New(cfgs ...*spotinst.Config) *Session {
+	s, err := newSession(cfgs...)
+	if err != nil {
+		logger := s.Config.Logger
+		if logger == nil {
+			logger = log.DefaultStdLogger
+		}
+		log.Leveled(logger).Error("SPOTINST: Failed to load the shared config, "+
+			"falling back to the defaults", "error", err)
+	}
+	return s
+}
+
+// Must returns s if err is nil, and panics otherwise. It wraps NewSession to
+// fail hard on invalid settings, e.g. in main functions:
+//
+//	sess := session.Must(session.NewSession())
+func Must(s *Session, err error) *Session {
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewSession is like New, but returns an error if the shared config file or
+// the environment holds invalid settings, or the selected profile does not
+// exist.
+func NewSession(cfgs ...*spotinst.Config) (*Session, error) {
+	s, err := newSession(cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// newSession returns the session defined by the shared config, the
+// environment and the given configs. If they hold invalid settings, it returns
+// the session defined by the defaults, the valid safety settings of the shared
+// config and the given configs, along with the error. The session is
+// read-only if the shared config could not be loaded.
+func newSession(cfgs ...*spotinst.Config) (*Session, error) {
+	shared, cfg, err := loadConfig()
+	if err != nil {
+		s := &Session{Config: spotinst.DefaultConfig()}
+		if shared != nil {
+			s.Config.Merge(shared.safetyConfig())
+		}
+		s.Config.Merge(cfgs...)
+		if shared == nil {
+			// Fail closed, as the profile may be read-only.
+			s.Config.ReadOnly = spotinst.Bool(true)
+		}
+		return s, err
+	}
+
 	s := &Session{Config: spotinst.DefaultConfig()}
+	s.Config.Merge(cfg)
+
+	// The account of the shared config applies to the default credentials.
+	if shared.Account != "" {
+		s.Config.Credentials = credentials.NewCredentials(&defaultAccountProvider{
+			creds:   s.Config.Credentials,
+			account: shared.Account,
+		})
+	}
+
 	s.Config.Merge(cfgs...)
-	return s
+	return s, nil
 }
@@ -0,0 +1,222 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigINI = `
+[default]
+base_url = https://default.example.com
+max_attempts = 5
+
+[prod]
+base_url = https://prod.example.com
+account = act-prod
+user_agent = my-controller/1.0
+timeout = 30s
+read_only = true
+`
+
+func setupEnv(t *testing.T, content string) {
+	filename := filepath.Join(t.TempDir(), "config")
+	if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(EnvVarConfigFile, filename)
+	t.Setenv(EnvVarProfile, "")
+	t.Setenv(EnvVarBaseURL, "")
+	t.Setenv(EnvVarDebug, "")
+	t.Setenv(credentials.EnvCredentialsVarToken, "token")
+	t.Setenv(credentials.EnvCredentialsVarAccount, "")
+}
+
+func TestNewSessionSharedConfig(t *testing.T) {
+	setupEnv(t, testConfigINI)
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://default.example.com", s.Config.BaseURL.String())
+	assert.Equal(t, 5, s.Config.RetryPolicy.MaxAttempts)
+	assert.Nil(t, s.Config.Logger)
+	assert.Equal(t, spotinst.DefaultUserAgent(), s.Config.UserAgent)
+
+	t.Setenv(EnvVarProfile, "prod")
+	s, err = NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://prod.example.com", s.Config.BaseURL.String())
+	assert.Equal(t, spotinst.DefaultRetryPolicy().MaxAttempts, s.Config.RetryPolicy.MaxAttempts)
+	assert.Equal(t, "my-controller/1.0 "+spotinst.DefaultUserAgent(), s.Config.UserAgent)
+	assert.Equal(t, 30*time.Second, s.Config.HTTPClient.Timeout)
+	assert.True(t, spotinst.BoolValue(s.Config.ReadOnly))
+
+	value, err := s.Config.Credentials.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "token", value.Token)
+	assert.Equal(t, "act-prod", value.Account)
+
+	// Accounts of the credentials take precedence.
+	t.Setenv(credentials.EnvCredentialsVarAccount, "act-env")
+	s, _ = NewSession()
+	value, _ = s.Config.Credentials.Get()
+	assert.Equal(t, "act-env", value.Account)
+}
+
+func TestNewSessionPrecedence(t *testing.T) {
+	setupEnv(t, testConfigINI)
+	t.Setenv(EnvVarBaseURL, "https://env.example.com")
+	t.Setenv(EnvVarDebug, "true")
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://env.example.com", s.Config.BaseURL.String())
+	assert.Implements(t, (*log.LeveledLogger)(nil), s.Config.Logger)
+
+	s, err = NewSession(spotinst.DefaultConfig().WithBaseURL("https://explicit.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://explicit.example.com", s.Config.BaseURL.String())
+}
+
+func TestNewSessionErrors(t *testing.T) {
+	setupEnv(t, `{"default": {"base_url": "https://json.example.com"}}`)
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://json.example.com", s.Config.BaseURL.String())
+
+	t.Setenv(EnvVarProfile, "missing")
+	_, err = NewSession()
+	assert.True(t, errors.Is(err, ErrSharedConfigProfileNotFound), "expected profile not found, got %v", err)
+
+	// New falls back to the defaults, read-only as the profile may be.
+	s = New(new(spotinst.Config).WithReadOnly(false))
+	assert.Equal(t, spotinst.DefaultBaseURL(), s.Config.BaseURL)
+	assert.True(t, spotinst.BoolValue(s.Config.ReadOnly))
+	assert.Panics(t, func() { Must(NewSession()) })
+
+	// Unparsable files as well.
+	setupEnv(t, "[default\nread_only = true\n")
+	_, err = NewSession()
+	assert.Error(t, err)
+	assert.True(t, spotinst.BoolValue(New().Config.ReadOnly))
+
+	t.Setenv(EnvVarProfile, "")
+	t.Setenv(EnvVarDebug, "maybe")
+	_, err = NewSession()
+	assert.Error(t, err)
+}
+
+func TestNewFailsClosed(t *testing.T) {
+	cases := map[string]struct {
+		Content string
+		Debug   string
+	}{
+		"invalid_file": {
+			Content: `
+[default]
+timeout = 30
+read_only = true
+dry_run = true
+`,
+		},
+		"invalid_env": {
+			Content: `
+[default]
+read_only = true
+validation = true
+`,
+			Debug: "maybe",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			setupEnv(t, c.Content)
+			if c.Debug != "" {
+				t.Setenv(EnvVarDebug, c.Debug)
+			}
+
+			_, err := NewSession()
+			assert.Error(t, err)
+
+			var logs []string
+			s := New(spotinst.DefaultConfig().WithLogger(log.LoggerFunc(func(format string, args ...interface{}) {
+				logs = append(logs, fmt.Sprintf(format, args...))
+			})))
+
+			// The valid safety settings are kept.
+			assert.True(t, spotinst.BoolValue(s.Config.ReadOnly))
+			assert.Equal(t, strings.Contains(c.Content, "dry_run"), spotinst.BoolValue(s.Config.DryRun))
+			assert.Equal(t, strings.Contains(c.Content, "validation"), spotinst.BoolValue(s.Config.Validation))
+			assert.Equal(t, spotinst.DefaultBaseURL(), s.Config.BaseURL)
+
+			if assert.Len(t, logs, 1) {
+				assert.Contains(t, logs[0], "Failed to load the shared config")
+			}
+
+			assert.Panics(t, func() { Must(NewSession()) })
+		})
+	}
+}
+
+func TestNewSessionMissingFile(t *testing.T) {
+	setupEnv(t, "")
+	t.Setenv(EnvVarConfigFile, filepath.Join(t.TempDir(), "missing"))
+
+	// The default profile is optional.
+	_, err := NewSession()
+	assert.NoError(t, err)
+
+	t.Setenv(EnvVarProfile, "prod")
+	_, err = NewSession()
+	assert.True(t, errors.Is(err, ErrSharedConfigProfileNotFound), "expected profile not found, got %v", err)
+}
+
+func TestNewSessionExpiresAt(t *testing.T) {
+	setupEnv(t, testConfigINI)
+	t.Setenv(EnvVarProfile, "prod")
+
+	expiresAt := time.Now().Add(time.Hour).Round(0)
+	p := &credentials.StaticProvider{Value: credentials.Value{Token: "token"}}
+	creds := credentials.NewCredentials(&expiringProvider{Provider: p, expiresAt: expiresAt})
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Config.Credentials = credentials.NewCredentials(&defaultAccountProvider{creds: creds, account: "act-prod"})
+
+	assert.Equal(t, expiresAt, s.Config.Credentials.ExpiresAt())
+}
+
+type expiringProvider struct {
+	credentials.Provider
+	expiresAt time.Time
+}
+
+func (p *expiringProvider) ExpiresAt() time.Time {
+	return p.expiresAt
+}
@@ -0,0 +1,324 @@
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/go-ini/ini"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
+)
+
+const (
+	// EnvVarConfigFile specifies the name of the environment variable points
+	// to the location of the shared config file.
+	EnvVarConfigFile = "SPOTINST_CONFIG_FILE"
+
+	// EnvVarProfile specifies the name of the environment variable points to
+	// the profile to load from the shared config file.
+	EnvVarProfile = "SPOTINST_PROFILE"
+
+	// EnvVarBaseURL specifies the name of the environment variable points to
+	// the base URL of the Spotinst API.
+	EnvVarBaseURL = "SPOTINST_BASE_URL"
+
+	// EnvVarDebug specifies the name of the environment variable enabling the
+	// logging of requests and responses at debug level, e.g. "true".
+	EnvVarDebug = "SPOTINST_DEBUG"
+)
+
+// ErrSharedConfigProfileNotFound is returned when the profile selected by
+// SPOTINST_PROFILE is not found in the shared config file.
+var ErrSharedConfigProfileNotFound = errors.New("spotinst: shared config profile not found")
+
+// DefaultSharedConfigFilename returns the SDK's default file path for the
+// shared config file.
+//
+// Builds the config file path based on the OS's platform.
+//   - Linux/Unix : $HOME/.spotinst/config
+//   - Windows    : %USERPROFILE%\.spotinst\config
+func DefaultSharedConfigFilename() string {
+	return filepath.Join(userHomeDir(), ".spotinst", "config")
+}
+
+// SharedConfig holds the settings of a profile of the shared config file. The
+// file is in the INI format of the shared credentials file:
+//
+//	[default]
+//	base_url = https://api.spotinst.io
+//	account = act-12345678
+//	user_agent = my-controller/1.0
+//	debug = false
+//	timeout = 30s
+//	max_attempts = 5
+//	read_only = false
+//
+// or in JSON, with an object per profile:
+//
+//	{"default": {"base_url": "https://api.spotinst.io", "debug": false}}
+type SharedConfig struct {
+	// The base URL of the Spotinst API.
+	BaseURL string `ini:"base_url" json:"base_url"`
+
+	// The account to operate on, if the credentials do not specify one.
+	Account string `ini:"account" json:"account"`
+
+	// A product token prepended to the SDK's User-Agent header.
+	UserAgent string `ini:"user_agent" json:"user_agent"`
+
+	// Whether to log requests and responses at debug level.
+	Debug bool `ini:"debug" json:"debug"`
+
+	// The timeout of HTTP requests, e.g. "30s".
+	Timeout string `ini:"timeout" json:"timeout"`
+
+	// The maximum number of attempts of requests that failed with a transient
+	// error, including the first one.
+	MaxAttempts int `ini:"max_attempts" json:"max_attempts"`
+
+	// Whether to log mutating requests instead of sending them.
+	DryRun bool `ini:"dry_run" json:"dry_run"`
+
+	// Whether to reject mutating requests.
+	ReadOnly bool `ini:"read_only" json:"read_only"`
+
+	// Whether to validate models before sending them.
+	Validation bool `ini:"validation" json:"validation"`
+}
+
+// LoadSharedConfig loads the given profile from the given shared config file.
+// A missing file yields an empty SharedConfig, while a missing profile yields
+// ErrSharedConfigProfileNotFound.
+func LoadSharedConfig(profile, filename string) (*SharedConfig, error) {
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return new(SharedConfig), nil
+	}
+
+	cfg, iniErr := loadSharedConfigINI(profile, filename)
+	if iniErr != nil {
+		var jsonErr error
+		if cfg, jsonErr = loadSharedConfigJSON(profile, filename); jsonErr != nil {
+			if errors.Is(iniErr, ErrSharedConfigProfileNotFound) ||
+				errors.Is(jsonErr, ErrSharedConfigProfileNotFound) {
+				return nil, fmt.Errorf("%w: %s", ErrSharedConfigProfileNotFound, profile)
+			}
+			return nil, fmt.Errorf("spotinst: failed to load shared config file: %v", iniErr)
+		}
+	}
+
+	return cfg, nil
+}
+
+func loadSharedConfigINI(profile, filename string) (*SharedConfig, error) {
+	file, err := ini.Load(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	section, err := file.GetSection(profile)
+	if err != nil {
+		return nil, ErrSharedConfigProfileNotFound
+	}
+
+	cfg := new(SharedConfig)
+	if err := section.MapTo(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func loadSharedConfigJSON(profile, filename string) (*SharedConfig, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var profiles map[string]*SharedConfig
+	if err := json.NewDecoder(f).Decode(&profiles); err != nil {
+		return nil, err
+	}
+
+	cfg, ok := profiles[profile]
+	if !ok || cfg == nil {
+		return nil, ErrSharedConfigProfileNotFound
+	}
+
+	return cfg, nil
+}
+
+// Config returns the SDK configuration defined by the shared config. Fields
+// that are not set are left empty, so that it can be merged over defaults.
+func (c *SharedConfig) Config() (*spotinst.Config, error) {
+	cfg := new(spotinst.Config)
+
+	if c.BaseURL != "" {
+		u, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("spotinst: invalid base_url: %v", err)
+		}
+		cfg.BaseURL = u
+	}
+
+	if c.UserAgent != "" {
+		cfg.UserAgent = spotinst.DefaultUserAgent()
+		cfg.WithUserAgent(c.UserAgent)
+	}
+
+	if c.Debug {
+		cfg.Logger = log.NewStdLogger(log.DefaultStdLogger, log.LevelDebug)
+	}
+
+	if c.Timeout != "" {
+		timeout, err := time.ParseDuration(c.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("spotinst: invalid timeout: %v", err)
+		}
+		client := spotinst.DefaultHTTPClient()
+		client.Timeout = timeout
+		cfg.HTTPClient = client
+	}
+
+	if c.MaxAttempts > 0 {
+		policy := spotinst.DefaultRetryPolicy()
+		policy.MaxAttempts = c.MaxAttempts
+		cfg.RetryPolicy = policy
+	}
+
+	cfg.Merge(c.safetyConfig())
+
+	return cfg, nil
+}
+
+// safetyConfig returns the SDK configuration defined by the safety settings
+// of the shared config, which guard against unwanted changes.
+func (c *SharedConfig) safetyConfig() *spotinst.Config {
+	cfg := new(spotinst.Config)
+
+	if c.DryRun {
+		cfg.DryRun = spotinst.Bool(true)
+	}
+
+	if c.ReadOnly {
+		cfg.ReadOnly = spotinst.Bool(true)
+	}
+
+	if c.Validation {
+		cfg.Validation = spotinst.Bool(true)
+	}
+
+	return cfg
+}
+
+// loadEnvConfig returns the shared config overridden by the environment.
+func loadEnvConfig(shared *SharedConfig) (*SharedConfig, error) {
+	c := *shared
+
+	if v := os.Getenv(EnvVarBaseURL); v != "" {
+		c.BaseURL = v
+	}
+
+	if v := os.Getenv(EnvVarDebug); v != "" {
+		debug, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("spotinst: invalid %s: %v", EnvVarDebug, err)
+		}
+		c.Debug = debug
+	}
+
+	return &c, nil
+}
+
+// loadConfig returns the configuration defined by the shared config file and
+// the environment, which takes precedence over the file. On error, it returns
+// the shared config loaded so far, if any, for its safety settings.
+func loadConfig() (*SharedConfig, *spotinst.Config, error) {
+	profile := os.Getenv(EnvVarProfile)
+	if profile == "" {
+		profile = credentials.DefaultProfile()
+	}
+
+	filename := os.Getenv(EnvVarConfigFile)
+	if filename == "" {
+		filename = DefaultSharedConfigFilename()
+	}
+
+	// The default profile is optional, but a selected one must exist.
+	explicit := os.Getenv(EnvVarProfile) != ""
+	if _, err := os.Stat(filename); os.IsNotExist(err) && explicit {
+		return nil, nil, fmt.Errorf("%w: %s (no such file: %s)",
+			ErrSharedConfigProfileNotFound, profile, filename)
+	}
+
+	shared, err := LoadSharedConfig(profile, filename)
+	if err != nil {
+		if !errors.Is(err, ErrSharedConfigProfileNotFound) || explicit {
+			return shared, nil, err
+		}
+		shared = new(SharedConfig)
+	}
+
+	env, err := loadEnvConfig(shared)
+	if err != nil {
+		return shared, nil, err
+	}
+
+	cfg, err := env.Config()
+	if err != nil {
+		return env, nil, err
+	}
+
+	return env, cfg, nil
+}
+
+// defaultAccountProvider wraps credentials to set an account when they do
+// not specify one.
+type defaultAccountProvider struct {
+	creds   *credentials.Credentials
+	account string
+}
+
+func (p *defaultAccountProvider) Retrieve() (credentials.Value, error) {
+	// Credentials only calls Retrieve when its value must be refreshed.
+	p.creds.Refresh()
+
+	value, err := p.creds.Get()
+	if err != nil {
+		return value, err
+	}
+	if value.Account == "" {
+		value.Account = p.account
+	}
+
+	return value, nil
+}
+
+func (p *defaultAccountProvider) IsExpired() bool {
+	return p.creds.IsExpired()
+}
+
+func (p *defaultAccountProvider) ExpiresAt() time.Time {
+	return p.creds.ExpiresAt()
+}
+
+func (p *defaultAccountProvider) String() string {
+	return "DefaultAccountProvider"
+}
+
+func userHomeDir() string {
+	if runtime.GOOS == "windows" { // Windows
+		return os.Getenv("USERPROFILE")
+	}
+
+	// *nix
+	return os.Getenv("HOME")
+}
@@ -0,0 +1,244 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	interfaceType  = reflect.TypeOf((*interface{})(nil)).Elem()
+	stringType     = reflect.TypeOf("")
+)
+
+// mirrors caches the mirror types of models.
+var mirrors sync.Map // map[reflect.Type]reflect.Type
+
+// recursiveFields holds the model types of the fields of mirror types that
+// hold recursive references, by field index, so that their generic values
+// can be checked by normalize.
+var recursiveFields sync.Map // map[reflect.Type]map[int]reflect.Type
+
+// mirrorOf returns the mirror type of the given model type: a struct with the
+// exported fields of the model, tagged with their JSON names for both YAML and
+// JSON, so that YAML documents can be decoded strictly into it, and then
+// encoded to JSON for the model to decode. Embedded structs are flattened,
+// times are strings and raw JSON values are generic values. Recursive
+// references are generic values as well, which normalize checks against the
+// mirror of their model type.
+func mirrorOf(t reflect.Type) reflect.Type {
+	if m, ok := mirrors.Load(t); ok {
+		return m.(reflect.Type)
+	}
+
+	m := newMirrorBuilder().mirror(t)
+	mirrors.Store(t, m)
+
+	return m
+}
+
+type mirrorBuilder struct {
+	// building holds the types being built, whose recursive references are
+	// decoded as generic values, as StructOf cannot build recursive types.
+	building map[reflect.Type]bool
+
+	// recursive is set once the type of the field being mirrored holds a
+	// recursive reference.
+	recursive bool
+}
+
+func newMirrorBuilder() *mirrorBuilder {
+	return &mirrorBuilder{building: make(map[reflect.Type]bool)}
+}
+
+func (b *mirrorBuilder) mirror(t reflect.Type) reflect.Type {
+	b.building[t] = true
+	defer delete(b.building, t)
+
+	var fields []reflect.StructField
+	seen := make(map[string]bool)
+	recursive := make(map[int]reflect.Type)
+	b.appendFields(&fields, seen, recursive, t)
+
+	m := reflect.StructOf(fields)
+	if len(recursive) > 0 {
+		recursiveFields.Store(m, recursive)
+	}
+
+	return m
+}
+
+func (b *mirrorBuilder) appendFields(fields *[]reflect.StructField, seen map[string]bool,
+	recursive map[int]reflect.Type, t reflect.Type) {
+	// Fields of embedded structs are shadowed by the fields of the struct.
+	var embedded []reflect.Type
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := sf.Tag.Get("json")
+		if sf.Anonymous && tag == "" && sf.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, sf.Type)
+			continue
+		}
+		if sf.PkgPath != "" || tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if name == "" {
+			name = sf.Name
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		b.recursive = false
+		ft := b.mirrorType(sf.Type)
+		if b.recursive {
+			recursive[len(*fields)] = sf.Type
+		}
+
+		*fields = append(*fields, reflect.StructField{
+			Name: fmt.Sprintf("F%d", len(*fields)),
+			Type: ft,
+			Tag:  reflect.StructTag(fmt.Sprintf(`json:"%s" yaml:"%s"`, name, name)),
+		})
+	}
+
+	for _, et := range embedded {
+		b.appendFields(fields, seen, recursive, et)
+	}
+}
+
+// mirrorType returns the type of the mirror field of a field of type t.
+func (b *mirrorBuilder) mirrorType(t reflect.Type) reflect.Type {
+	switch {
+	case t == timeType:
+		return stringType
+	case t == rawMessageType:
+		return interfaceType
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if t.Elem().Kind() == reflect.Struct && b.building[t.Elem()] {
+			b.recursive = true
+			return interfaceType
+		}
+		return reflect.PtrTo(b.mirrorType(t.Elem()))
+	case reflect.Struct:
+		if b.building[t] {
+			b.recursive = true
+			return interfaceType
+		}
+		// The recursive references of nested structs are theirs to check.
+		recursive := b.recursive
+		m := b.mirror(t)
+		b.recursive = recursive
+		return m
+	case reflect.Slice:
+		return reflect.SliceOf(b.mirrorType(t.Elem()))
+	case reflect.Map:
+		return reflect.MapOf(t.Key(), b.mirrorType(t.Elem()))
+	case reflect.Interface:
+		return interfaceType
+	}
+
+	return t
+}
+
+// normalize replaces the generic values held by the given mirror value, as
+// decoded from YAML, with values that can be encoded to JSON, i.e. with
+// objects keyed by strings. It returns an error if the generic values of
+// recursive references do not match their model type, e.g. hold unknown
+// fields.
+func normalize(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return normalize(v.Elem())
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			v.Set(reflect.ValueOf(genericValue(v.Elem().Interface())))
+		}
+	case reflect.Struct:
+		var recursive map[int]reflect.Type
+		if r, ok := recursiveFields.Load(v.Type()); ok {
+			recursive = r.(map[int]reflect.Type)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if err := normalize(v.Field(i)); err != nil {
+				return err
+			}
+			if t, ok := recursive[i]; ok {
+				name := v.Type().Field(i).Tag.Get("json")
+				if err := checkRecursive(v.Field(i), t); err != nil {
+					return fmt.Errorf("%s: %v", name, err)
+				}
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := normalize(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			e := reflect.New(v.Type().Elem()).Elem()
+			e.Set(v.MapIndex(k))
+			if err := normalize(e); err != nil {
+				return err
+			}
+			v.SetMapIndex(k, e)
+		}
+	}
+	return nil
+}
+
+// checkRecursive decodes the given normalized value of a field holding
+// recursive references strictly into the mirror of its model type t, so that
+// unknown fields and values of the wrong type are rejected.
+func checkRecursive(v reflect.Value, t reflect.Type) error {
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+
+	m := reflect.New(newMirrorBuilder().mirrorType(t))
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(m.Interface()); err != nil {
+		return errors.New(strings.TrimPrefix(err.Error(), "json: "))
+	}
+
+	return normalize(m)
+}
+
+// genericValue returns the given value decoded from YAML with its objects
+// keyed by strings.
+func genericValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = genericValue(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range v {
+			v[i] = genericValue(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,76 @@
+package spec
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+type node struct {
+	Name     *string `json:"name,omitempty"`
+	Children []*node `json:"children,omitempty"`
+	Parent   *node   `json:"parent,omitempty"`
+}
+
+func decodeMirror(t *testing.T, in string, model interface{}) error {
+	m := reflect.New(mirrorOf(reflect.TypeOf(model).Elem()))
+	if err := yaml.UnmarshalStrict([]byte(in), m.Interface()); err != nil {
+		t.Fatal(err)
+	}
+	return normalize(m)
+}
+
+func TestMirrorRecursiveTypes(t *testing.T) {
+	valid := `
+name: a
+parent: {name: b}
+children:
+  - name: c
+    children: [{name: d, parent: {name: e}}]
+`
+	assert.NoError(t, decodeMirror(t, valid, new(node)))
+
+	tests := map[string]struct {
+		in   string
+		want string
+	}{
+		"unknown field": {
+			in:   "children: [{name: c, nmae: d}]",
+			want: `children: unknown field "nmae"`,
+		},
+		"nested unknown field": {
+			in:   "parent: {children: [{parent: {nmae: d}}]}",
+			want: `parent: children: parent: unknown field "nmae"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, decodeMirror(t, tt.in, new(node)), tt.want)
+		})
+	}
+}
+
+func TestStrip(t *testing.T) {
+	v := map[string]interface{}{
+		"id":          "sig-1",
+		"name":        "foo",
+		"description": nil,
+		"compute": map[string]interface{}{
+			"id":       "ls-1",
+			"keyPair":  nil,
+			"launches": []interface{}{map[string]interface{}{"createdAt": "2020-01-01T00:00:00Z"}},
+		},
+	}
+
+	// Only the read-only fields of the resource itself are stripped.
+	assert.Equal(t, map[string]interface{}{
+		"name": "foo",
+		"compute": map[string]interface{}{
+			"id":       "ls-1",
+			"launches": []interface{}{map[string]interface{}{"createdAt": "2020-01-01T00:00:00Z"}},
+		},
+	}, strip(v, true))
+}
@@ -0,0 +1,343 @@
+// Package spec loads and saves resource definitions as spec files, e.g. to
+// store them in git. A spec file holds one or more YAML or JSON documents,
+// separated by "---" lines, each with the kind of the resource and its spec:
+//
+//	kind: ElastigroupAWS
+//	spec:
+//	  name: my-group
+//	  capacity:
+//	    minimum: 1
+//	    maximum: 3
+//	    target: 2
+//	---
+//	kind: OceanAWS
+//	spec:
+//	  name: my-cluster
+//
+// The kinds of the resources of a service are registered by its package, e.g.
+// service/elastigroup/providers/aws registers ElastigroupAWS, so it must be
+// imported for its documents to be decoded:
+//
+//	docs, err := spec.Unmarshal(data)
+//	if err != nil {
+//		return err
+//	}
+//	for _, doc := range docs {
+//		switch s := doc.Spec.(type) {
+//		case *aws.Group:
+//			// ...
+//		}
+//	}
+//
+// Decoding is strict: unknown fields and values of the wrong type are errors,
+// reported with their line numbers. The read-only fields of the resources
+// (see jsonutil.RegisterReadOnlyFields), e.g. id or createdAt, are not
+// encoded, while those of nested objects, e.g. the IDs of launch specs, are.
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/jsonutil"
+	"gopkg.in/yaml.v2"
+)
+
+// A Format is the format of encoded documents.
+type Format int
+
+const (
+	// FormatYAML encodes documents in YAML.
+	FormatYAML Format = iota
+
+	// FormatJSON encodes documents in JSON, indented.
+	FormatJSON
+)
+
+// A Document is a resource definition.
+type Document struct {
+	// The kind of the resource, e.g. "ElastigroupAWS".
+	Kind string
+
+	// The spec of the resource: a pointer to the model of its kind, e.g. an
+	// *aws.Group.
+	Spec interface{}
+}
+
+var (
+	kindsMu sync.RWMutex
+	kinds   = make(map[string]reflect.Type) // kind -> struct type
+	types   = make(map[reflect.Type]string) // struct type -> kind
+)
+
+// Register registers the kind of the resources of the given model type, e.g.
+// Register("ElastigroupAWS", (*aws.Group)(nil)). It panics if the kind or the
+// type is already registered.
+func Register(kind string, model interface{}) {
+	t := reflect.TypeOf(model)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("spec: model of kind %q must be a pointer to a struct", kind))
+	}
+
+	kindsMu.Lock()
+	defer kindsMu.Unlock()
+
+	if _, ok := kinds[kind]; ok {
+		panic(fmt.Sprintf("spec: kind %q registered twice", kind))
+	}
+	if k, ok := types[t.Elem()]; ok {
+		panic(fmt.Sprintf("spec: type %v registered twice, as %q and %q", t, k, kind))
+	}
+
+	kinds[kind] = t.Elem()
+	types[t.Elem()] = kind
+}
+
+// Kinds returns the sorted list of registered kinds.
+func Kinds() []string {
+	kindsMu.RLock()
+	defer kindsMu.RUnlock()
+
+	list := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		list = append(list, kind)
+	}
+	sort.Strings(list)
+
+	return list
+}
+
+// KindOf returns the kind registered for the type of the given model, e.g.
+// "ElastigroupAWS" for an *aws.Group, if any.
+func KindOf(model interface{}) (string, bool) {
+	t := reflect.TypeOf(model)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return "", false
+	}
+
+	kindsMu.RLock()
+	defer kindsMu.RUnlock()
+
+	kind, ok := types[t.Elem()]
+	return kind, ok
+}
+
+func typeOf(kind string) (reflect.Type, bool) {
+	kindsMu.RLock()
+	defer kindsMu.RUnlock()
+
+	t, ok := kinds[kind]
+	return t, ok
+}
+
+// Unmarshal decodes the YAML or JSON documents in data.
+func Unmarshal(data []byte) ([]*Document, error) {
+	return Decode(bytes.NewReader(data))
+}
+
+// Decode decodes the YAML or JSON documents read from r.
+func Decode(r io.Reader) ([]*Document, error) {
+	dec := yaml.NewDecoder(r)
+	dec.SetStrict(true)
+
+	var docs []*Document
+	for i := 1; ; i++ {
+		var d document
+		if err := dec.Decode(&d); err != nil {
+			if err == io.EOF {
+				return docs, nil
+			}
+			return nil, fmt.Errorf("spec: document %d: %v", i, yamlError(err))
+		}
+		if d.Document == nil {
+			// Empty document, e.g. a trailing "---".
+			continue
+		}
+		docs = append(docs, d.Document)
+	}
+}
+
+// Marshal encodes the given documents in the given format. Documents are
+// separated by "---" lines. Documents with no kind are encoded with the kind
+// registered for the type of their spec.
+func Marshal(format Format, docs ...*Document) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, format, docs...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode writes the given documents to w in the given format; see Marshal.
+func Encode(w io.Writer, format Format, docs ...*Document) error {
+	for i, doc := range docs {
+		kind := doc.Kind
+		if kind == "" {
+			var ok bool
+			if kind, ok = KindOf(doc.Spec); !ok {
+				return fmt.Errorf("spec: document %d: no kind registered for %T", i+1, doc.Spec)
+			}
+		}
+
+		s, err := specValue(doc.Spec)
+		if err != nil {
+			return fmt.Errorf("spec: document %d: %v", i+1, err)
+		}
+
+		var b []byte
+		switch format {
+		case FormatYAML:
+			b, err = yaml.Marshal(yaml.MapSlice{{Key: "kind", Value: kind}, {Key: "spec", Value: s}})
+		case FormatJSON:
+			b, err = json.MarshalIndent(struct {
+				Kind string      `json:"kind"`
+				Spec interface{} `json:"spec"`
+			}{kind, s}, "", "  ")
+			b = append(b, '\n')
+		default:
+			err = fmt.Errorf("unknown format %d", format)
+		}
+		if err != nil {
+			return fmt.Errorf("spec: document %d: %v", i+1, err)
+		}
+
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// specValue returns the JSON form of the given model as a generic value,
+// without its read-only fields and its null fields.
+func specValue(model interface{}) (interface{}, error) {
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return strip(v, true), nil
+}
+
+// strip removes the null fields of the objects in v, at any depth, and the
+// read-only fields of v itself if root is set.
+func strip(v interface{}, root bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e == nil || (root && jsonutil.IsReadOnlyField(k)) {
+				delete(v, k)
+				continue
+			}
+			v[k] = strip(e, false)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = strip(e, false)
+		}
+	}
+	return v
+}
+
+// document decodes a document, first reading its kind, then its spec into the
+// mirror type of the kind's model, so that errors are reported against its
+// JSON fields, with their line numbers.
+type document struct {
+	*Document
+}
+
+func (d *document) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var head struct {
+		Kind string      `yaml:"kind"`
+		Spec interface{} `yaml:"spec"`
+	}
+	if err := unmarshal(&head); err != nil {
+		return err
+	}
+	if head.Kind == "" {
+		if head.Spec == nil {
+			return nil
+		}
+		return errors.New("missing kind")
+	}
+
+	t, ok := typeOf(head.Kind)
+	if !ok {
+		return fmt.Errorf("unknown kind %q", head.Kind)
+	}
+
+	body := reflect.New(reflect.StructOf([]reflect.StructField{
+		{Name: "Kind", Type: reflect.TypeOf(""), Tag: `yaml:"kind"`},
+		{Name: "Spec", Type: reflect.PtrTo(mirrorOf(t)), Tag: `yaml:"spec"`},
+	}))
+	if err := unmarshal(body.Interface()); err != nil {
+		return err
+	}
+
+	model := reflect.New(t)
+	if s := body.Elem().Field(1); !s.IsNil() {
+		if err := normalize(s); err != nil {
+			return err
+		}
+		b, err := json.Marshal(s.Interface())
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, model.Interface()); err != nil {
+			return err
+		}
+	}
+
+	d.Document = &Document{Kind: head.Kind, Spec: model.Interface()}
+	return nil
+}
+
+var (
+	unknownFieldRegexp = regexp.MustCompile(`field (\S+) not found in type .*`)
+	mirrorTypeRegexp   = regexp.MustCompile(`(\[\])?\*?struct \{.*\}`)
+)
+
+// yamlError returns the given decoding error with the names of the mirror
+// types, which are not meaningful to users, replaced.
+func yamlError(err error) error {
+	var msgs []string
+	if te, ok := err.(*yaml.TypeError); ok {
+		msgs = te.Errors
+	} else {
+		msgs = []string{err.Error()}
+	}
+
+	for i, msg := range msgs {
+		msg = unknownFieldRegexp.ReplaceAllString(msg, `unknown field "$1"`)
+		msg = mirrorTypeRegexp.ReplaceAllStringFunc(msg, func(s string) string {
+			if s[0] == '[' {
+				return "list of objects"
+			}
+			return "object"
+		})
+		msgs[i] = msg
+	}
+
+	if len(msgs) == 1 {
+		return errors.New(msgs[0])
+	}
+	return &yaml.TypeError{Errors: msgs}
+}
@@ -0,0 +1,195 @@
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	_ "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	_ "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	_ "github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	_ "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	_ "github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	_ "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	_ "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	_ "github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshal(t *testing.T) {
+	in := `
+kind: ElastigroupAWS
+spec:
+  id: sig-1
+  name: foo
+  capacity:
+    minimum: 1
+    maximum: 3
+  compute:
+    instanceTypes:
+      spot: [m5.large, c5.large]
+  thirdPartiesIntegration:
+    ecs:
+      clusterName: bar
+      autoScale:
+        isEnabled: true
+        attributes:
+          - key: k
+            value: v
+  createdAt: 2020-01-01T00:00:00Z
+---
+{"kind": "MultaiBalancer", "spec": {"name": "lb", "tags": [{"key": "env", "value": "prod"}]}}
+---
+`
+
+	docs, err := spec.Unmarshal([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, docs, 2) {
+		return
+	}
+
+	assert.Equal(t, "ElastigroupAWS", docs[0].Kind)
+	group := docs[0].Spec.(*aws.Group)
+	assert.Equal(t, "sig-1", spotinst.StringValue(group.ID))
+	assert.Equal(t, "foo", spotinst.StringValue(group.Name))
+	assert.Equal(t, 3, spotinst.IntValue(group.Capacity.Maximum))
+	assert.Equal(t, []string{"m5.large", "c5.large"}, group.Compute.InstanceTypes.Spot)
+	assert.Equal(t, true, spotinst.BoolValue(group.Integration.EC2ContainerService.AutoScale.IsEnabled))
+	assert.Equal(t, "v", spotinst.StringValue(group.Integration.EC2ContainerService.AutoScale.Attributes[0].Value))
+	assert.Equal(t, 2020, group.CreatedAt.Year())
+
+	assert.Equal(t, "MultaiBalancer", docs[1].Kind)
+	lb := docs[1].Spec.(*multai.LoadBalancer)
+	assert.Equal(t, "prod", spotinst.StringValue(lb.Tags[0].Value))
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tests := map[string]struct {
+		in   string
+		want string
+	}{
+		"unknown field": {
+			in: `kind: ElastigroupAWS
+spec:
+  name: foo
+  capacity:
+    minimum: 1
+    maximun: 3
+`,
+			want: `spec: document 1: line 6: unknown field "maximun"`,
+		},
+		"wrong type": {
+			in: `kind: MultaiBalancer
+spec:
+  name: lb
+---
+kind: ElastigroupAWS
+spec:
+  capacity:
+    minimum: one
+`,
+			want: "spec: document 2: line 8: cannot unmarshal !!str `one` into int",
+		},
+		"wrong type of object": {
+			in: `kind: ElastigroupAWS
+spec:
+  compute: [1]
+`,
+			want: "spec: document 1: line 3: cannot unmarshal !!seq into object",
+		},
+		"unknown top-level field": {
+			in: `kind: ElastigroupAWS
+metadata: {}
+`,
+			want: `spec: document 1: line 2: unknown field "metadata"`,
+		},
+		"unknown kind": {
+			in:   `{"kind": "Unknown", "spec": {}}`,
+			want: `spec: document 1: unknown kind "Unknown"`,
+		},
+		"missing kind": {
+			in:   `{"spec": {"name": "foo"}}`,
+			want: `spec: document 1: missing kind`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := spec.Unmarshal([]byte(tt.in))
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	group := new(aws.Group)
+	group.SetId(spotinst.String("sig-1"))
+	group.SetName(spotinst.String("foo"))
+	group.SetDescription(nil)
+	group.SetCapacity(&aws.Capacity{Minimum: spotinst.Int(1), Maximum: spotinst.Int(3)})
+
+	b, err := spec.Marshal(spec.FormatYAML,
+		&spec.Document{Spec: group},
+		&spec.Document{Spec: &multai.LoadBalancer{Name: spotinst.String("lb")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `kind: ElastigroupAWS
+spec:
+  capacity:
+    maximum: 3
+    minimum: 1
+  name: foo
+---
+kind: MultaiBalancer
+spec:
+  name: lb
+`, string(b))
+
+	// Round trip.
+	docs, err := spec.Unmarshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, docs, 2) {
+		return
+	}
+	assert.Equal(t, "foo", spotinst.StringValue(docs[0].Spec.(*aws.Group).Name))
+	assert.Nil(t, docs[0].Spec.(*aws.Group).ID)
+
+	b, err = spec.Marshal(spec.FormatJSON, &spec.Document{Spec: group})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"kind": "ElastigroupAWS", "spec": {"name": "foo", "capacity": {"minimum": 1, "maximum": 3}}}`, string(b))
+
+	_, err = spec.Marshal(spec.FormatYAML, &spec.Document{Spec: new(aws.Capacity)})
+	assert.EqualError(t, err, "spec: document 1: no kind registered for *aws.Capacity")
+}
+
+func TestKinds(t *testing.T) {
+	kinds := spec.Kinds()
+	assert.Len(t, kinds, 21)
+
+	for _, kind := range kinds {
+		t.Run(kind, func(t *testing.T) {
+			docs, err := spec.Unmarshal([]byte("kind: " + kind + "\nspec: {}\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !assert.Len(t, docs, 1) {
+				return
+			}
+
+			b, err := spec.Marshal(spec.FormatYAML, &spec.Document{Spec: docs[0].Spec})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "kind: "+kind+"\nspec: {}\n", string(b))
+		})
+	}
+}
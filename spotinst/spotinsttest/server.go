@@ -0,0 +1,551 @@
+// Package spotinsttest provides an in-memory fake of the Spotinst API, to
+// unit-test code built on the service clients without hitting the real API.
+//
+// The fake server implements the CRUD endpoints of Elastigroup (AWS, Azure and
+// GCP), Ocean (AWS and GCP), Multai, Healthcheck and Subscription, and answers
+// with the same {request, response: {items, errors}} envelope as the real API:
+//
+//	srv := spotinsttest.NewServer()
+//	defer srv.Close()
+//
+//	svc := elastigroup.New(srv.Session())
+//	out, err := svc.CloudProviderAWS().Create(ctx, &aws.CreateGroupInput{...})
+//
+// Requests to sub-resources of an existing resource (e.g. a group's status)
+// succeed with no items, unless a custom handler is registered with Handle.
+package spotinsttest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+// Credentials used by the configuration returned by Server.Config. Requests
+// not authenticated with Token fail with 401 Unauthorized.
+const (
+	Token   = "spotinsttest-token"
+	Account = "act-12345678"
+)
+
+// An Error is an API error returned by the fake server.
+type Error struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The API error code (e.g. "GROUP_DOESNT_EXIST") and message.
+	Code    string
+	Message string
+
+	// The field the error relates to, if any.
+	Field string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// A Hook is called before the fake server handles a request. If it returns a
+// non-nil error, the request fails with that error instead of being handled.
+// Errors other than *Error fail with 500 Internal Server Error.
+type Hook func(req *http.Request) error
+
+// A HandlerFunc handles requests to a custom endpoint. It returns the items of
+// the response, or an error as a Hook does. body holds the request body.
+type HandlerFunc func(req *http.Request, body []byte) ([]interface{}, error)
+
+type handler struct {
+	method string
+	path   []string
+	fn     HandlerFunc
+}
+
+// A Server is an in-memory fake of the Spotinst API listening on a system
+// chosen port on the local loopback interface.
+type Server struct {
+	// URL is the base URL of the server, of the form http://ipaddr:port with
+	// no trailing slash.
+	URL string
+
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	collections []*collection
+	handlers    []*handler
+	hooks       []Hook
+	latency     time.Duration
+	seq         int
+	now         func() time.Time
+}
+
+// NewServer starts and returns a new fake server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		collections: defaultCollections(),
+		now:         time.Now,
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server and blocks until all outstanding requests on
+// this server have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Config returns a configuration of the SDK pointing to the server, with
+// static credentials and the default retry policy.
+func (s *Server) Config() *spotinst.Config {
+	return spotinst.DefaultConfig().
+		WithBaseURL(s.URL).
+		WithHTTPClient(s.srv.Client()).
+		WithCredentials(credentials.NewStaticCredentials(Token, Account))
+}
+
+// Session returns a new session configured with Config, to create service
+// clients from, e.g. elastigroup.New(srv.Session()).
+func (s *Server) Session() *session.Session {
+	return session.New(s.Config())
+}
+
+// Put stores the given resource (e.g. an *aws.Group) in the collection at
+// path (e.g. "/aws/ec2/group"), generating its ID unless one is set, and
+// returns its ID. It is useful to seed the server with existing resources.
+func (s *Server) Put(path string, v interface{}) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(splitPath(path))
+	if c == nil || len(splitPath(path)) != len(splitPath(c.path)) {
+		return "", fmt.Errorf("spotinsttest: unknown collection %q", path)
+	}
+
+	item, err := toObject(v)
+	if err != nil {
+		return "", err
+	}
+
+	item = c.create(item, s.nextID(c.prefix), s.now())
+	return item["id"].(string), nil
+}
+
+// Get returns the resource with the given ID in the collection at path, as
+// decoded JSON, and whether it exists.
+func (s *Server) Get(path, id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(splitPath(path))
+	if c == nil {
+		return nil, false
+	}
+
+	item, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	return clone(item), true
+}
+
+// Reset removes all the stored resources, custom handlers and hooks, and the
+// configured latency.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collections = defaultCollections()
+	s.handlers = nil
+	s.hooks = nil
+	s.latency = 0
+}
+
+// Handle registers a custom handler for the given method and path. Path
+// segments in braces (e.g. "/aws/ec2/group/{groupId}/roll") match any value.
+// Custom handlers take precedence over the built-in endpoints.
+func (s *Server) Handle(method, path string, fn HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers = append(s.handlers, &handler{
+		method: method,
+		path:   splitPath(path),
+		fn:     fn,
+	})
+}
+
+// AddHook registers a hook called before each request is handled.
+func (s *Server) AddHook(hook Hook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hooks = append(s.hooks, hook)
+}
+
+// InjectError makes the next count requests matching the given method and
+// path prefix fail with err. An empty method matches any method, and a count
+// of zero or less makes all matching requests fail until Reset is called.
+func (s *Server) InjectError(method, path string, count int, err *Error) {
+	var mu sync.Mutex
+	always, remaining := count <= 0, count
+
+	s.AddHook(func(req *http.Request) error {
+		if method != "" && req.Method != method {
+			return nil
+		}
+		if !strings.HasPrefix(req.URL.Path, path) {
+			return nil
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !always {
+			if remaining == 0 {
+				return nil
+			}
+			remaining--
+		}
+
+		return err
+	})
+}
+
+// SetLatency delays all responses by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latency = d
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	latency, hooks := s.latency, s.hooks
+	s.mu.Unlock()
+
+	if latency > 0 {
+		if err := sleep(req.Context(), latency); err != nil {
+			return
+		}
+	}
+
+	if req.Header.Get("Authorization") != "Bearer "+Token {
+		s.writeError(w, req, &Error{
+			StatusCode: http.StatusUnauthorized,
+			Code:       "UNAUTHORIZED",
+			Message:    "Invalid or missing token",
+		})
+		return
+	}
+
+	for _, hook := range hooks {
+		if err := hook(req); err != nil {
+			s.writeError(w, req, err)
+			return
+		}
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+
+	if fn := s.handler(req.Method, splitPath(req.URL.Path)); fn != nil {
+		items, err := fn(req, body)
+		if err != nil {
+			s.writeError(w, req, err)
+			return
+		}
+		s.writeItems(w, req, items)
+		return
+	}
+
+	items, err := s.serveCollection(req, body)
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+
+	s.writeItems(w, req, items)
+}
+
+// serveCollection handles the built-in CRUD endpoints.
+func (s *Server) serveCollection(req *http.Request, body []byte) ([]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := splitPath(req.URL.Path)
+	c := s.collection(segments)
+	if c == nil {
+		return nil, &Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "NOT_FOUND",
+			Message:    fmt.Sprintf("Unknown endpoint: %s %s", req.Method, req.URL.Path),
+		}
+	}
+
+	now := s.now()
+	rest := segments[len(splitPath(c.path)):]
+
+	// Collection: /path
+	if len(rest) == 0 {
+		switch req.Method {
+		case http.MethodGet:
+			return c.list(req.URL.Query()), nil
+		case http.MethodPost:
+			item, err := decodeItem(body, c.key)
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{clone(c.create(item, s.nextID(c.prefix), now))}, nil
+		}
+		return nil, errMethodNotAllowed(req)
+	}
+
+	id := rest[0]
+	if _, ok := c.items[id]; !ok {
+		return nil, errNotFound(c, id)
+	}
+
+	// Sub-resources: /path/{id}/...
+	if len(rest) > 1 {
+		return []interface{}{}, nil
+	}
+
+	// Resource: /path/{id}
+	switch req.Method {
+	case http.MethodGet:
+		return []interface{}{clone(c.items[id])}, nil
+	case http.MethodPut:
+		fields, err := decodeItem(body, c.key)
+		if err != nil {
+			return nil, err
+		}
+		item, _ := c.update(id, fields, now)
+		return []interface{}{clone(item)}, nil
+	case http.MethodDelete:
+		c.delete(id)
+		return []interface{}{}, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+// collection returns the collection serving the given path segments, or nil.
+func (s *Server) collection(segments []string) *collection {
+	var match *collection
+	for _, c := range s.collections {
+		prefix := splitPath(c.path)
+		if len(segments) < len(prefix) {
+			continue
+		}
+		if strings.Join(segments[:len(prefix)], "/") != strings.Join(prefix, "/") {
+			continue
+		}
+		if match == nil || len(prefix) > len(splitPath(match.path)) {
+			match = c
+		}
+	}
+	return match
+}
+
+// handler returns the custom handler for the given method and path segments,
+// or nil.
+func (s *Server) handler(method string, segments []string) HandlerFunc {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, h := range s.handlers {
+		if h.method != method || len(h.path) != len(segments) {
+			continue
+		}
+		matched := true
+		for i, seg := range h.path {
+			if !strings.HasPrefix(seg, "{") && seg != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return h.fn
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) nextID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s%08x", prefix, s.seq)
+}
+
+func (s *Server) requestID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", s.seq, s.seq)
+}
+
+type envelope struct {
+	Request struct {
+		ID        string `json:"id"`
+		URL       string `json:"url"`
+		Method    string `json:"method"`
+		Timestamp string `json:"timestamp"`
+	} `json:"request"`
+	Response struct {
+		Status struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"status"`
+		Errors []apiError    `json:"errors,omitempty"`
+		Items  []interface{} `json:"items"`
+		Count  int           `json:"count"`
+	} `json:"response"`
+}
+
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+func (s *Server) writeItems(w http.ResponseWriter, req *http.Request, items []interface{}) {
+	env := s.newEnvelope(req, http.StatusOK)
+	env.Response.Items = items
+	env.Response.Count = len(items)
+	s.write(w, http.StatusOK, env)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, req *http.Request, err error) {
+	e, ok := err.(*Error)
+	if !ok {
+		e = &Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "INTERNAL_ERROR",
+			Message:    err.Error(),
+		}
+	}
+
+	env := s.newEnvelope(req, e.StatusCode)
+	env.Response.Items = []interface{}{}
+	env.Response.Errors = []apiError{{
+		Code:    e.Code,
+		Message: e.Message,
+		Field:   e.Field,
+	}}
+	s.write(w, e.StatusCode, env)
+}
+
+func (s *Server) newEnvelope(req *http.Request, code int) *envelope {
+	env := new(envelope)
+	env.Request.ID = s.requestID()
+	env.Request.URL = req.URL.RequestURI()
+	env.Request.Method = req.Method
+	env.Request.Timestamp = s.now().Format(time.RFC3339)
+	env.Response.Status.Code = code
+	env.Response.Status.Message = http.StatusText(code)
+	return env
+}
+
+func (s *Server) write(w http.ResponseWriter, code int, env *envelope) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(env)
+}
+
+// decodeItem decodes a request body of the form {"<key>": {...}}.
+func decodeItem(body []byte, key string) (map[string]interface{}, error) {
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil, &Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION_ERROR",
+			Message:    fmt.Sprintf("Invalid request body: %v", err),
+		}
+	}
+
+	var item map[string]interface{}
+	if raw, ok := wrapper[key]; ok {
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, &Error{
+				StatusCode: http.StatusBadRequest,
+				Code:       "VALIDATION_ERROR",
+				Message:    fmt.Sprintf("Invalid %s: %v", key, err),
+				Field:      key,
+			}
+		}
+	}
+	if item == nil {
+		return nil, &Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION_ERROR",
+			Message:    fmt.Sprintf("Missing %s", key),
+			Field:      key,
+		}
+	}
+
+	return item, nil
+}
+
+// toObject converts v to a decoded JSON object.
+func toObject(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj = make(map[string]interface{})
+	}
+
+	return obj, nil
+}
+
+func errNotFound(c *collection, id string) error {
+	return &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       "NOT_FOUND",
+		Message:    fmt.Sprintf("%s %q does not exist", c.key, id),
+	}
+}
+
+func errMethodNotAllowed(req *http.Request) error {
+	return &Error{
+		StatusCode: http.StatusMethodNotAllowed,
+		Code:       "METHOD_NOT_ALLOWED",
+		Message:    fmt.Sprintf("Method %s is not allowed on %s", req.Method, req.URL.Path),
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
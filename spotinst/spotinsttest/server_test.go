@@ -0,0 +1,140 @@
+package spotinsttest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	ocean "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerCRUD(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	svc := aws.New(srv.Session())
+
+	group := new(aws.Group)
+	group.SetName(spotinst.String("foo"))
+	group.SetDescription(spotinst.String("bar"))
+
+	created, err := svc.Create(ctx, &aws.CreateGroupInput{Group: group})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := spotinst.StringValue(created.Group.ID)
+	assert.Regexp(t, `^sig-[0-9a-f]{8}$`, id)
+	assert.Equal(t, "foo", spotinst.StringValue(created.Group.Name))
+	assert.NotNil(t, created.Group.CreatedAt)
+
+	update := new(aws.Group)
+	update.SetId(spotinst.String(id))
+	update.SetName(spotinst.String("baz"))
+	update.SetDescription(nil)
+
+	updated, err := svc.Update(ctx, &aws.UpdateGroupInput{Group: update})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "baz", spotinst.StringValue(updated.Group.Name))
+	assert.Nil(t, updated.Group.Description)
+
+	read, err := svc.Read(ctx, &aws.ReadGroupInput{GroupID: spotinst.String(id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "baz", spotinst.StringValue(read.Group.Name))
+
+	list, err := svc.List(ctx, &aws.ListGroupsInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, list.Groups, 1)
+
+	if _, err := svc.Delete(ctx, &aws.DeleteGroupInput{GroupID: spotinst.String(id)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = svc.Read(ctx, &aws.ReadGroupInput{GroupID: spotinst.String(id)})
+	assert.True(t, errors.Is(err, client.ErrNotFound), "expected not found, got %v", err)
+	assert.NotEmpty(t, client.RequestID(err))
+}
+
+func TestServerListFilter(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	for _, oceanID := range []string{"o-1", "o-1", "o-2"} {
+		spec := new(ocean.LaunchSpec)
+		spec.SetOceanId(spotinst.String(oceanID))
+		if _, err := srv.Put("/ocean/aws/k8s/launchSpec", spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out, err := ocean.New(srv.Session()).ListLaunchSpecs(context.Background(),
+		&ocean.ListLaunchSpecsInput{OceanID: spotinst.String("o-1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, out.LaunchSpecs, 2)
+}
+
+func TestServerHooks(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	svc := aws.New(srv.Session(), new(spotinst.Config).WithRetryPolicy(spotinst.NoRetryPolicy()))
+
+	srv.InjectError(http.MethodGet, "/aws/ec2/group", 1, &Error{
+		StatusCode: http.StatusTooManyRequests,
+		Code:       "RATE_LIMIT",
+		Message:    "Too many requests",
+	})
+
+	_, err := svc.List(ctx, &aws.ListGroupsInput{})
+	assert.True(t, errors.Is(err, client.ErrRateLimited), "expected rate limited, got %v", err)
+
+	_, err = svc.List(ctx, &aws.ListGroupsInput{})
+	assert.NoError(t, err)
+
+	srv.SetLatency(time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	_, err = svc.List(ctx, &aws.ListGroupsInput{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "expected deadline exceeded, got %v", err)
+}
+
+func TestServerHandle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	id, err := srv.Put("/aws/ec2/group", &aws.Group{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.Handle(http.MethodGet, "/aws/ec2/group/{groupId}/roll/{rollId}",
+		func(req *http.Request, body []byte) ([]interface{}, error) {
+			return []interface{}{map[string]interface{}{
+				"id":     "sbgd-1",
+				"status": "FINISHED",
+			}}, nil
+		})
+
+	out, err := aws.WaitUntilRollFinished(context.Background(), aws.New(srv.Session()),
+		id, "sbgd-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "FINISHED", spotinst.StringValue(out.RollStatus))
+}
@@ -0,0 +1,193 @@
+package spotinsttest
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// A collection holds the resources of one kind, e.g. Elastigroup AWS groups.
+type collection struct {
+	// The path of the collection, e.g. "/aws/ec2/group".
+	path string
+
+	// The key wrapping resources in request bodies, e.g. "group".
+	key string
+
+	// The prefix of generated resource IDs, e.g. "sig-".
+	prefix string
+
+	// The resources of the collection, keyed by ID.
+	items map[string]map[string]interface{}
+}
+
+// defaultCollections returns the collections served by default.
+func defaultCollections() []*collection {
+	specs := []struct{ path, key, prefix string }{
+		// Elastigroup.
+		{"/aws/ec2/group", "group", "sig-"},
+		{"/compute/azure/group", "group", "sig-"},
+		{"/azure/compute/task", "task", "at-"},
+		{"/gcp/gce/group", "group", "sig-"},
+
+		// Ocean.
+		{"/ocean/aws/k8s/cluster", "cluster", "o-"},
+		{"/ocean/aws/k8s/launchSpec", "launchSpec", "ols-"},
+		{"/ocean/aws/ecs/cluster", "cluster", "o-"},
+		{"/ocean/aws/ecs/launchSpec", "launchSpec", "ols-"},
+		{"/ocean/gcp/k8s/cluster", "cluster", "o-"},
+		{"/ocean/gcp/k8s/launchSpec", "launchSpec", "ols-"},
+
+		// Multai.
+		{"/loadBalancer/balancer", "balancer", "lb-"},
+		{"/loadBalancer/listener", "listener", "lis-"},
+		{"/loadBalancer/routingRule", "routingRule", "rr-"},
+		{"/loadBalancer/middleware", "middleware", "mw-"},
+		{"/loadBalancer/targetSet", "targetSet", "ts-"},
+		{"/loadBalancer/target", "target", "t-"},
+		{"/loadBalancer/runtime", "runtime", "rt-"},
+		{"/loadBalancer/deployment", "deployment", "dp-"},
+		{"/loadBalancer/certificate", "certificate", "ce-"},
+
+		// Healthcheck.
+		{"/healthCheck", "healthCheck", "hc-"},
+
+		// Subscription.
+		{"/events/subscription", "subscription", "sis-"},
+	}
+
+	cs := make([]*collection, len(specs))
+	for i, spec := range specs {
+		cs[i] = &collection{
+			path:   spec.path,
+			key:    spec.key,
+			prefix: spec.prefix,
+			items:  make(map[string]map[string]interface{}),
+		}
+	}
+
+	return cs
+}
+
+// list returns the resources of the collection, sorted by ID, whose top-level
+// fields match the given query parameters (e.g. ?oceanId=o-1234).
+func (c *collection) list(query url.Values) []interface{} {
+	ids := make([]string, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		if item := c.items[id]; matches(item, query) {
+			items = append(items, clone(item))
+		}
+	}
+
+	return items
+}
+
+// create stores a new resource, generating its ID unless one is set.
+func (c *collection) create(item map[string]interface{}, id string, now time.Time) map[string]interface{} {
+	if v, ok := item["id"].(string); ok && v != "" {
+		id = v
+	}
+
+	item["id"] = id
+	item["createdAt"] = now.Format(time.RFC3339)
+	item["updatedAt"] = now.Format(time.RFC3339)
+	c.items[id] = item
+
+	return item
+}
+
+// update merges the given fields into an existing resource. Null fields are
+// removed, objects are merged recursively, and any other value is replaced.
+func (c *collection) update(id string, fields map[string]interface{}, now time.Time) (map[string]interface{}, bool) {
+	item, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	delete(fields, "id")
+	merge(item, fields)
+	item["updatedAt"] = now.Format(time.RFC3339)
+
+	return item, true
+}
+
+// delete removes a resource and reports whether it existed.
+func (c *collection) delete(id string) bool {
+	_, ok := c.items[id]
+	delete(c.items, id)
+	return ok
+}
+
+// clone returns a deep copy of a decoded JSON value, so that stored resources
+// can be encoded without holding the server's lock.
+func clone(item map[string]interface{}) map[string]interface{} {
+	return cloneValue(item).(map[string]interface{})
+}
+
+func cloneValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = cloneValue(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = cloneValue(val)
+		}
+		return out
+	}
+	return v
+}
+
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		if sv, ok := v.(map[string]interface{}); ok {
+			if dv, ok := dst[k].(map[string]interface{}); ok {
+				merge(dv, sv)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+// matches reports whether the top-level fields of item match the given query
+// parameters. Parameters the item does not have (e.g. accountId) are ignored.
+func matches(item map[string]interface{}, query url.Values) bool {
+	for k := range query {
+		v, ok := item[k]
+		if !ok {
+			continue
+		}
+		if fmt.Sprint(v) != query.Get(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitPath returns the segments of the given path, ignoring empty ones.
+func splitPath(path string) []string {
+	var segments []string
+	for _, s := range strings.Split(path, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
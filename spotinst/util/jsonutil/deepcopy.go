@@ -0,0 +1,39 @@
+package jsonutil
+
+import "encoding/json"
+
+// DeepCopy returns a deep copy of the unknown fields.
+func (u UnknownFields) DeepCopy() UnknownFields {
+	if u == nil {
+		return nil
+	}
+
+	out := make(UnknownFields, len(u))
+	for k, v := range u {
+		out[k] = append(json.RawMessage(nil), v...)
+	}
+
+	return out
+}
+
+// DeepCopyValue returns a deep copy of the given decoded JSON value, i.e. of
+// its nested objects and arrays. Other values are returned as is.
+func DeepCopyValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[k] = DeepCopyValue(e)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = DeepCopyValue(e)
+		}
+		return out
+	case json.RawMessage:
+		return append(json.RawMessage(nil), v...)
+	}
+	return v
+}
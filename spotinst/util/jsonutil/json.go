@@ -19,6 +19,13 @@ import (
 //   * its field name is present in nullFields.
 // The JSON key for each selected field is taken from the field's json: struct tag.
 func MarshalJSON(schema interface{}, forceSendFields, nullFields []string) ([]byte, error) {
+	return MarshalJSONWithUnknownFields(schema, forceSendFields, nullFields, nil)
+}
+
+// MarshalJSONWithUnknownFields is like MarshalJSON, but also includes the given
+// unknown fields of schema as is; see UnknownFields. Modeled fields take
+// precedence.
+func MarshalJSONWithUnknownFields(schema interface{}, forceSendFields, nullFields []string, unknownFields UnknownFields) ([]byte, error) {
 	mustInclude := make(map[string]struct{})
 	for _, f := range forceSendFields {
 		mustInclude[f] = struct{}{}
@@ -34,6 +41,12 @@ func MarshalJSON(schema interface{}, forceSendFields, nullFields []string) ([]by
 		return nil, err
 	}
 
+	for k, v := range unknownFields {
+		if _, ok := dataMap[k]; !ok {
+			dataMap[k] = v
+		}
+	}
+
 	return json.Marshal(dataMap)
 }
 
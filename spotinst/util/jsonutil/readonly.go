@@ -0,0 +1,38 @@
+package jsonutil
+
+import "sync"
+
+var (
+	readOnlyMu sync.RWMutex
+
+	// readOnlyFields holds the JSON names of the fields set by the API, which
+	// cannot be updated, e.g. Group.ID or Group.CreatedAt.
+	readOnlyFields = map[string]struct{}{
+		"id":        {},
+		"createdAt": {},
+		"updatedAt": {},
+		"deletedAt": {},
+	}
+)
+
+// RegisterReadOnlyFields registers additional JSON names of fields set by the
+// API, at any depth, which are ignored when computing updates and stripped from
+// encoded specs.
+func RegisterReadOnlyFields(names ...string) {
+	readOnlyMu.Lock()
+	defer readOnlyMu.Unlock()
+
+	for _, name := range names {
+		readOnlyFields[name] = struct{}{}
+	}
+}
+
+// IsReadOnlyField reports whether the field with the given JSON name is set by
+// the API.
+func IsReadOnlyField(name string) bool {
+	readOnlyMu.RLock()
+	defer readOnlyMu.RUnlock()
+
+	_, ok := readOnlyFields[name]
+	return ok
+}
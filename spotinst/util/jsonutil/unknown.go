@@ -0,0 +1,227 @@
+package jsonutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// UnknownFields holds the JSON properties of an object that are not modeled by
+// its schema, e.g. fields added by a newer version of the API. They are kept
+// by UnmarshalJSON and sent back as is by MarshalJSONWithUnknownFields, so
+// that Read-modify-Update cycles do not wipe settings the SDK does not know
+// about. StripUnknownFields removes them from an encoded object.
+type UnknownFields map[string]json.RawMessage
+
+// UnmarshalJSON decodes data into schema, which must be a pointer to a struct
+// with no UnmarshalJSON method, and stores the properties of data that match
+// none of the schema's fields into unknownFields. The matching of keys is
+// case-insensitive, as in encoding/json.
+func UnmarshalJSON(data []byte, schema interface{}, unknownFields *UnknownFields) error {
+	unmarshal := json.Unmarshal
+	if _, ok := schema.(json.Unmarshaler); ok {
+		// The method is promoted from an embedded struct, e.g. AutoScale.
+		unmarshal = unmarshalFields
+	}
+	if err := unmarshal(data, schema); err != nil {
+		return err
+	}
+
+	*unknownFields = nil
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil || m == nil {
+		// Not an object (e.g. null), so there is nothing to keep.
+		return nil
+	}
+
+	known := knownFields(reflect.TypeOf(schema).Elem())
+	for k, v := range m {
+		if _, ok := known[strings.ToLower(k)]; ok {
+			continue
+		}
+		if *unknownFields == nil {
+			*unknownFields = make(UnknownFields)
+		}
+		(*unknownFields)[k] = v
+	}
+
+	return nil
+}
+
+// unmarshalFields decodes data into schema field by field, for schemas that
+// embed structs with an UnmarshalJSON method, which would otherwise take over
+// the decoding of the whole object. Embedded structs are decoded from the whole
+// object, and other fields from the matching property.
+func unmarshalFields(data []byte, schema interface{}) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil || m == nil {
+		return err
+	}
+
+	v := reflect.ValueOf(schema).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+
+		if sf.Anonymous && tag == "" && sf.Type.Kind() == reflect.Struct {
+			if err := json.Unmarshal(data, v.Field(i).Addr().Interface()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if sf.PkgPath != "" || tag == "-" {
+			continue
+		}
+
+		name := sf.Name
+		if n := strings.Split(tag, ",")[0]; n != "" {
+			name = n
+		}
+
+		raw, ok := m[name]
+		if !ok {
+			for k, r := range m {
+				if strings.EqualFold(k, name) {
+					raw, ok = r, true
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		if err := json.Unmarshal(raw, v.Field(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// knownFieldsCache maps struct types to the types of their fields, by
+// lowercase JSON name.
+var knownFieldsCache sync.Map // map[reflect.Type]map[string]reflect.Type
+
+// knownFields returns the types of the fields of the given struct type,
+// including those of its embedded structs, by lowercase JSON name.
+func knownFields(t reflect.Type) map[string]reflect.Type {
+	if v, ok := knownFieldsCache.Load(t); ok {
+		return v.(map[string]reflect.Type)
+	}
+
+	known := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		name := sf.Name
+		if tag := sf.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if n := strings.Split(tag, ",")[0]; n != "" {
+				name = n
+			}
+		}
+
+		if sf.Anonymous && sf.Tag.Get("json") == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range knownFields(ft) {
+					known[k] = v
+				}
+				continue
+			}
+		}
+
+		if sf.PkgPath != "" {
+			// Ignore unexported fields.
+			continue
+		}
+
+		known[strings.ToLower(name)] = sf.Type
+	}
+
+	knownFieldsCache.Store(t, known)
+	return known
+}
+
+// StripUnknownFields removes the properties of the JSON encoding of schema,
+// at any depth, that match none of the fields of their schema, e.g. the
+// unknown fields sent back by MarshalJSONWithUnknownFields, so that only the
+// fields modeled by the SDK are sent.
+func StripUnknownFields(data []byte, schema interface{}) ([]byte, error) {
+	if schema == nil {
+		return data, nil
+	}
+	return stripUnknownFields(data, reflect.TypeOf(schema))
+}
+
+// stripUnknownFields removes the unknown fields of data, as encoded from a
+// value of the given type.
+func stripUnknownFields(data []byte, t reflect.Type) ([]byte, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(data, &m); err != nil || m == nil {
+			// Not an object (e.g. null or a time), so there is nothing to strip.
+			return data, nil
+		}
+		known := knownFields(t)
+		for k, v := range m {
+			ft, ok := known[strings.ToLower(k)]
+			if !ok {
+				delete(m, k)
+				continue
+			}
+			b, err := stripUnknownFields(v, ft)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = b
+		}
+		return json.Marshal(m)
+
+	case reflect.Map:
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(data, &m); err != nil || m == nil {
+			return data, nil
+		}
+		for k, v := range m {
+			b, err := stripUnknownFields(v, t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			m[k] = b
+		}
+		return json.Marshal(m)
+
+	case reflect.Slice, reflect.Array:
+		var a []json.RawMessage
+		if err := json.Unmarshal(data, &a); err != nil || a == nil {
+			// Not an array (e.g. null or a []byte).
+			return data, nil
+		}
+		for i, v := range a {
+			b, err := stripUnknownFields(v, t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			a[i] = b
+		}
+		return json.Marshal(a)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,68 @@
+package jsonutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type object struct {
+	Name  *string `json:"name,omitempty"`
+	Child *object `json:"child,omitempty"`
+
+	forceSendFields []string
+	nullFields      []string
+	unknownFields   UnknownFields
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	type noMethod object
+	raw := noMethod(o)
+	return MarshalJSONWithUnknownFields(raw, o.forceSendFields, o.nullFields, o.unknownFields)
+}
+
+func (o *object) UnmarshalJSON(b []byte) error {
+	type noMethod object
+	return UnmarshalJSON(b, (*noMethod)(o), &o.unknownFields)
+}
+
+func TestUnknownFields(t *testing.T) {
+	in := `{"name":"a","NEW":1,"child":{"name":"b","new":{"x":[1,2]}}}`
+
+	var o object
+	if err := json.Unmarshal([]byte(in), &o); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (UnknownFields{"NEW": json.RawMessage(`1`)}); !reflect.DeepEqual(o.unknownFields, want) {
+		t.Errorf("expect: %s, got: %s", want, o.unknownFields)
+	}
+	if o.Child.unknownFields == nil {
+		t.Errorf("expect unknown fields of nested objects to be kept")
+	}
+
+	// Modeled fields are sent as set, and unknown ones as is.
+	o.Name = stringPtr("c")
+	o.Child.Name = nil
+	o.Child.nullFields = []string{"Name"}
+
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"NEW":1,"child":{"name":null,"new":{"x":[1,2]}},"name":"c"}`; string(b) != want {
+		t.Errorf("expect: %s, got: %s", want, b)
+	}
+
+	// Opt-out.
+	body := map[string][]object{"objects": {o}}
+	if b, err = json.Marshal(body); err != nil {
+		t.Fatal(err)
+	}
+	if b, err = StripUnknownFields(b, body); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"objects":[{"child":{"name":null},"name":"c"}]}`; string(b) != want {
+		t.Errorf("expect: %s, got: %s", want, b)
+	}
+}
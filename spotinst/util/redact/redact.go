@@ -0,0 +1,140 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Placeholder replaces redacted values.
+const Placeholder = "[REDACTED]"
+
+var (
+	mu sync.RWMutex
+
+	// sensitiveHeaders holds the canonical names of headers carrying secrets.
+	sensitiveHeaders = map[string]struct{}{
+		"Authorization":       {},
+		"Proxy-Authorization": {},
+		"Cookie":              {},
+		"Set-Cookie":          {},
+	}
+
+	// sensitiveKeys holds the lower-cased JSON keys of fields carrying secrets,
+	// e.g. RancherIntegration.SecretKey or multai.Certificate.KeyPEMBlock.
+	sensitiveKeys = map[string]struct{}{
+		"token":       {},
+		"acltoken":    {},
+		"accesskey":   {},
+		"secretkey":   {},
+		"pemkey":      {},
+		"keypemblock": {},
+		"password":    {},
+	}
+)
+
+// RegisterKeys registers additional JSON keys whose values should be redacted.
+// Keys are matched case-insensitively.
+func RegisterKeys(keys ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, k := range keys {
+		sensitiveKeys[strings.ToLower(k)] = struct{}{}
+	}
+}
+
+// RegisterHeaders registers additional HTTP headers whose values should be
+// redacted.
+func RegisterHeaders(headers ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, h := range headers {
+		sensitiveHeaders[http.CanonicalHeaderKey(h)] = struct{}{}
+	}
+}
+
+// IsSensitiveKey reports whether the value of the given JSON key is redacted.
+func IsSensitiveKey(key string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	_, ok := sensitiveKeys[strings.ToLower(key)]
+	return ok
+}
+
+// IsSensitiveHeader reports whether the value of the given header is redacted.
+func IsSensitiveHeader(header string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	_, ok := sensitiveHeaders[http.CanonicalHeaderKey(header)]
+	return ok
+}
+
+// Header returns a copy of h with the values of sensitive headers redacted.
+// The authentication scheme (e.g. "Bearer") is preserved.
+func Header(h http.Header) http.Header {
+	out := make(http.Header, len(h))
+	for k, vs := range h {
+		if !IsSensitiveHeader(k) {
+			out[k] = append([]string(nil), vs...)
+			continue
+		}
+		redacted := make([]string, len(vs))
+		for i, v := range vs {
+			if j := strings.IndexByte(v, ' '); j > 0 && strings.HasSuffix(k, "Authorization") {
+				redacted[i] = v[:j+1] + Placeholder
+				continue
+			}
+			redacted[i] = Placeholder
+		}
+		out[k] = redacted
+	}
+	return out
+}
+
+// JSON returns a copy of the given JSON document with the values of sensitive
+// keys redacted, at any depth. Input that is not valid JSON is returned as is.
+func JSON(data []byte) []byte {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return data
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return data
+	}
+
+	out, err := json.Marshal(Value(v))
+	if err != nil {
+		return data
+	}
+
+	return out
+}
+
+// Value redacts the values of sensitive keys in a decoded JSON value (as
+// produced by json.Unmarshal into an interface{}), in place, and returns it.
+func Value(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if IsSensitiveKey(k) && val != nil {
+				t[k] = Placeholder
+				continue
+			}
+			t[k] = Value(val)
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = Value(val)
+		}
+	}
+	return v
+}
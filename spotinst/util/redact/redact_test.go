@@ -0,0 +1,68 @@
+package redact
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeader(t *testing.T) {
+	in := http.Header{
+		"Authorization": {"Bearer secret"},
+		"Cookie":        {"session=secret"},
+		"Content-Type":  {"application/json"},
+	}
+
+	out := Header(in)
+
+	assert.Equal(t, "Bearer [REDACTED]", out.Get("Authorization"))
+	assert.Equal(t, "[REDACTED]", out.Get("Cookie"))
+	assert.Equal(t, "application/json", out.Get("Content-Type"))
+	assert.Equal(t, "Bearer secret", in.Get("Authorization"))
+}
+
+func TestJSON(t *testing.T) {
+	cases := map[string]struct {
+		Input    string
+		Expected string
+	}{
+		"nested": {
+			Input:    `{"group":{"thirdPartiesIntegration":{"rancher":{"accessKey":"a","secretKey":"b","masterHost":"h"}}}}`,
+			Expected: `{"group":{"thirdPartiesIntegration":{"rancher":{"accessKey":"[REDACTED]","secretKey":"[REDACTED]","masterHost":"h"}}}}`,
+		},
+		"array": {
+			Input:    `{"certificates":[{"name":"c","keyPemBlock":"k","certPemBlock":"p"}]}`,
+			Expected: `{"certificates":[{"name":"c","keyPemBlock":"[REDACTED]","certPemBlock":"p"}]}`,
+		},
+		"case_insensitive": {
+			Input:    `{"Password":"p","count":10}`,
+			Expected: `{"Password":"[REDACTED]","count":10}`,
+		},
+		"null_value": {
+			Input:    `{"password":null}`,
+			Expected: `{"password":null}`,
+		},
+		"not_json": {
+			Input:    `<html>Bad Gateway</html>`,
+			Expected: `<html>Bad Gateway</html>`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := string(JSON([]byte(c.Input)))
+			if c.Expected[0] == '{' {
+				assert.JSONEq(t, c.Expected, out)
+			} else {
+				assert.Equal(t, c.Expected, out)
+			}
+		})
+	}
+}
+
+func TestRegisterKeys(t *testing.T) {
+	assert.False(t, IsSensitiveKey("userData"))
+	RegisterKeys("UserData")
+	assert.True(t, IsSensitiveKey("userData"))
+}
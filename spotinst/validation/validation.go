@@ -0,0 +1,174 @@
+// Package validation provides the errors returned by the Validate methods of
+// the main models, e.g. aws.Group or ocean/aws.Cluster, which catch common
+// mistakes in resource specs before they are sent to the API:
+//
+//	if err := group.Validate(); err != nil {
+//		var errs validation.Errors
+//		if errors.As(err, &errs) {
+//			for _, e := range errs {
+//				fmt.Println(e.Path, e.Message)
+//			}
+//		}
+//	}
+//
+// Models are validated automatically by Create and Update methods if enabled
+// with spotinst.Config.WithValidation.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalid is matched by the errors returned by Validate methods, using
+// errors.Is.
+var ErrInvalid = errors.New("spotinst: invalid resource")
+
+// A Validatable is a model that can be validated.
+type Validatable interface {
+	// Validate returns an Errors listing the invalid fields of the model, if
+	// any, or nil. Fields that are not set are not validated, so that partial
+	// models, e.g. updates, are valid. A nil model is valid.
+	Validate() error
+
+	// ValidateCreate is like Validate, but also lists the fields that must be
+	// set to create the model and are not.
+	ValidateCreate() error
+}
+
+// A FieldError describes an invalid field.
+type FieldError struct {
+	// The JSON path of the field, e.g. "capacity.minimum" or
+	// "scaling.up[0].metricName".
+	Path string
+
+	// Why the field is invalid, e.g. "must be set".
+	Message string
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// Errors is a list of field errors.
+type Errors []*FieldError
+
+// Error implements the error interface.
+func (es Errors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return ErrInvalid.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+// Is reports whether target is ErrInvalid.
+func (es Errors) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// A Validator collects the field errors of a model. Its zero value is ready
+// to use.
+type Validator struct {
+	errs Errors
+}
+
+// Errorf adds an error for the field at the given path.
+func (v *Validator) Errorf(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, &FieldError{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Err returns the errors collected so far as an Errors, or nil if there are
+// none.
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Required adds an error if the string at the given path is not set or empty.
+func (v *Validator) Required(path string, value *string) {
+	if value == nil || *value == "" {
+		v.Errorf(path, "must be set")
+	}
+}
+
+// Present adds an error if the field at the given path is not set, as told
+// by set, e.g. a nil object or list.
+func (v *Validator) Present(path string, set bool) {
+	if !set {
+		v.Errorf(path, "must be set")
+	}
+}
+
+// Range adds an error if the number at the given path is set and outside of
+// [min, max].
+func (v *Validator) Range(path string, value *float64, min, max float64) {
+	if value != nil && (*value < min || *value > max) {
+		v.Errorf(path, "must be between %v and %v, got %v", min, max, *value)
+	}
+}
+
+// RangeInt is like Range, for integers.
+func (v *Validator) RangeInt(path string, value *int, min, max int) {
+	if value != nil && (*value < min || *value > max) {
+		v.Errorf(path, "must be between %d and %d, got %d", min, max, *value)
+	}
+}
+
+// Capacity adds errors if the capacity at the given path is inconsistent,
+// i.e. if its minimum, maximum or target, where set, are negative, or the
+// minimum is greater than the maximum, or the target out of their bounds.
+func (v *Validator) Capacity(path string, minimum, maximum, target *int) {
+	for _, f := range []struct {
+		name  string
+		value *int
+	}{{"minimum", minimum}, {"maximum", maximum}, {"target", target}} {
+		if f.value != nil && *f.value < 0 {
+			v.Errorf(Field(path, f.name), "must not be negative, got %d", *f.value)
+		}
+	}
+
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		v.Errorf(Field(path, "minimum"), "must not be greater than maximum (%d > %d)", *minimum, *maximum)
+	}
+	if target != nil && minimum != nil && *target < *minimum {
+		v.Errorf(Field(path, "target"), "must not be less than minimum (%d < %d)", *target, *minimum)
+	}
+	if target != nil && maximum != nil && *target > *maximum {
+		v.Errorf(Field(path, "target"), "must not be greater than maximum (%d > %d)", *target, *maximum)
+	}
+}
+
+// Field returns the path of the named field of the object at the given path,
+// e.g. "capacity.minimum".
+func Field(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// Index returns the path of the i-th element of the list at the given path,
+// e.g. "scaling.up[0]".
+func Index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// IsNull reports whether the named field is in the given nullFields of a
+// model, i.e. whether it is set to be sent as null.
+func IsNull(nullFields []string, name string) bool {
+	for _, f := range nullFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestCapacity(t *testing.T) {
+	tests := map[string]struct {
+		minimum, maximum, target *int
+		want                     []string
+	}{
+		"valid": {
+			minimum: intPtr(1), maximum: intPtr(3), target: intPtr(2),
+		},
+		"partial": {
+			target: intPtr(5),
+		},
+		"minimum greater than maximum": {
+			minimum: intPtr(3), maximum: intPtr(1),
+			want: []string{"capacity.minimum: must not be greater than maximum (3 > 1)"},
+		},
+		"target out of bounds": {
+			minimum: intPtr(1), maximum: intPtr(3), target: intPtr(4),
+			want: []string{"capacity.target: must not be greater than maximum (4 > 3)"},
+		},
+		"negative": {
+			minimum: intPtr(-1), target: intPtr(-2),
+			want: []string{
+				"capacity.minimum: must not be negative, got -1",
+				"capacity.target: must not be negative, got -2",
+				"capacity.target: must not be less than minimum (-2 < -1)",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := new(Validator)
+			v.Capacity("capacity", tt.minimum, tt.maximum, tt.target)
+
+			var got []string
+			for _, e := range v.errs {
+				got = append(got, e.Error())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	v := new(Validator)
+	assert.NoError(t, v.Err())
+
+	v.Required(Index(Field("scaling", "up"), 0)+".metricName", nil)
+	v.Range("strategy.risk", new(float64), 0, 100)
+	v.RangeInt("strategy.spotPercentage", intPtr(101), 0, 100)
+	v.Present("compute", false)
+	v.Present("capacity", true)
+
+	err := v.Err()
+	assert.True(t, errors.Is(err, ErrInvalid))
+	assert.EqualError(t, err, "spotinst: invalid resource: "+
+		"scaling.up[0].metricName: must be set; "+
+		"strategy.spotPercentage: must be between 0 and 100, got 101; "+
+		"compute: must be set")
+}
@@ -0,0 +1,143 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotStable is returned when a group did not become stable before the
+// waiter's timeout expired or its context was done. Use it with errors.Is.
+var ErrNotStable = errors.New("spotinst: group is not stable")
+
+// Stability describes how far a group is from being stable, that is, running
+// as many healthy instances as its target capacity.
+type Stability struct {
+	// The target capacity of the group.
+	Target int
+
+	// The IDs of the instances that are running.
+	Running []string
+
+	// The IDs of the instances that are running and healthy.
+	Healthy []string
+
+	// The IDs of the instances that are not running yet (e.g. pending spot
+	// requests or instances still booting).
+	Pending []string
+
+	// The IDs of the running instances that are not healthy yet.
+	Unhealthy []string
+}
+
+// Stable reports whether the group runs exactly as many instances as its
+// target capacity, and all of them are healthy.
+func (s *Stability) Stable() bool {
+	return len(s.Running) == s.Target &&
+		len(s.Healthy) == s.Target &&
+		len(s.Unhealthy) == 0
+}
+
+// Missing returns the number of healthy instances still missing to reach the
+// target capacity, or a negative number if the group runs too many instances.
+func (s *Stability) Missing() int {
+	return s.Target - len(s.Healthy)
+}
+
+// String returns a human-readable summary of what is still missing.
+func (s *Stability) String() string {
+	parts := []string{
+		fmt.Sprintf("%d/%d healthy", len(s.Healthy), s.Target),
+		fmt.Sprintf("%d running", len(s.Running)),
+	}
+	if len(s.Pending) > 0 {
+		parts = append(parts, fmt.Sprintf("pending: %s", strings.Join(s.Pending, ", ")))
+	}
+	if len(s.Unhealthy) > 0 {
+		parts = append(parts, fmt.Sprintf("unhealthy: %s", strings.Join(s.Unhealthy, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Progress returns the stability as a Progress value, in percentage of the
+// target capacity.
+func (s *Stability) Progress(id string) Progress {
+	p := Progress{
+		ID:    id,
+		State: "STABILIZING",
+		Unit:  "percentage",
+		Value: 100,
+	}
+	if s.Stable() {
+		p.State = "STABLE"
+	} else if s.Target > 0 {
+		if p.Value = len(s.Healthy) * 100 / s.Target; p.Value > 100 {
+			p.Value = 100
+		}
+	}
+	return p
+}
+
+// A StabilityError is returned by stability waiters when a group did not
+// become stable in time. It holds the last observed stability.
+type StabilityError struct {
+	// The ID of the group.
+	ResourceID string
+
+	// The last observed stability.
+	Stability *Stability
+
+	// The error that stopped the waiter (e.g. ErrTimeout).
+	Err error
+}
+
+func (e *StabilityError) Error() string {
+	msg := fmt.Sprintf("spotinst: group %q is not stable", e.ResourceID)
+	if e.Stability != nil {
+		msg = fmt.Sprintf("%s (%s)", msg, e.Stability)
+	}
+	return fmt.Sprintf("%s: %v", msg, e.Err)
+}
+
+// Is reports whether target is ErrNotStable.
+func (e *StabilityError) Is(target error) bool {
+	return target == ErrNotStable
+}
+
+// Unwrap returns the error that stopped the waiter.
+func (e *StabilityError) Unwrap() error {
+	return e.Err
+}
+
+// A StabilityFunc observes the current stability of a group.
+type StabilityFunc func(ctx context.Context) (*Stability, error)
+
+// PollStable calls fn until the group it observes is stable, reporting the
+// progress after each poll. If the timeout expires or the context is done
+// first, a *StabilityError holding the last observed stability is returned.
+func PollStable(ctx context.Context, resourceID string, opts *Options, fn StabilityFunc) (*Stability, error) {
+	var last *Stability
+
+	err := Poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		s, err := fn(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		last = s
+		opts.Report(s.Progress(resourceID))
+
+		return s.Stable(), nil
+	})
+
+	if err != nil && last != nil && (errors.Is(err, ErrTimeout) || ctx.Err() != nil) {
+		err = &StabilityError{
+			ResourceID: resourceID,
+			Stability:  last,
+			Err:        err,
+		}
+	}
+
+	return last, err
+}
@@ -0,0 +1,162 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultPollInterval is the default interval between two polls.
+const DefaultPollInterval = 15 * time.Second
+
+// ErrTimeout is returned when a waiter's timeout expired before the awaited
+// condition was met.
+var ErrTimeout = errors.New("spotinst: timed out while waiting")
+
+// Options configures a waiter.
+type Options struct {
+	// The interval between two polls. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait. Zero means no timeout other than the one
+	// carried by the context.
+	Timeout time.Duration
+
+	// OnProgress, if set, is called after each poll with the latest progress
+	// of the awaited operation.
+	OnProgress func(Progress)
+}
+
+// Progress reports the progress of a long-running operation.
+type Progress struct {
+	// The ID of the operation (e.g. a roll ID).
+	ID string
+
+	// The state of the operation as reported by the API (e.g. "IN_PROGRESS").
+	State string
+
+	// The progress value (e.g. a percentage) and its unit.
+	Value int
+	Unit  string
+}
+
+// A ConditionFunc polls the state of an operation. It returns true once the
+// awaited condition is met, or an error to stop waiting.
+type ConditionFunc func(ctx context.Context) (done bool, err error)
+
+// Poll calls condition immediately and then every PollInterval, until it
+// returns true or an error, the timeout expires, or the context is done.
+func Poll(ctx context.Context, opts *Options, condition ConditionFunc) error {
+	if opts == nil {
+		opts = new(Options)
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := condition(ctx)
+		if err != nil {
+			return timeoutError(ctx, opts, err)
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return timeoutError(ctx, opts, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// timeoutError translates an error caused by the expiry of the waiter's own
+// timeout into ErrTimeout.
+func timeoutError(ctx context.Context, opts *Options, err error) error {
+	if opts.Timeout > 0 && errors.Is(err, context.DeadlineExceeded) &&
+		errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %v", ErrTimeout, opts.Timeout)
+	}
+	return err
+}
+
+// Report calls the options' progress callback, if any.
+func (o *Options) Report(p Progress) {
+	if o != nil && o.OnProgress != nil {
+		o.OnProgress(p)
+	}
+}
+
+// Roll states reported by the API.
+const (
+	RollStateFinished = "FINISHED"
+	RollStateFailed   = "FAILED"
+	RollStateStopped  = "STOPPED"
+)
+
+// Errors returned by roll waiters when a roll reached a terminal state other
+// than RollStateFinished. Use them with errors.Is.
+var (
+	ErrRollFailed  = errors.New("spotinst: roll failed")
+	ErrRollStopped = errors.New("spotinst: roll stopped")
+)
+
+// A RollError is returned by roll waiters when a roll failed or was stopped.
+type RollError struct {
+	// The ID of the resource (e.g. a group or cluster ID).
+	ResourceID string
+
+	// The ID of the roll.
+	RollID string
+
+	// The terminal state of the roll.
+	State string
+}
+
+func (e *RollError) Error() string {
+	return fmt.Sprintf("spotinst: roll %q of %q ended with state %s",
+		e.RollID, e.ResourceID, e.State)
+}
+
+// Is reports whether target is ErrRollFailed or ErrRollStopped, depending on
+// the roll's terminal state.
+func (e *RollError) Is(target error) bool {
+	switch {
+	case strings.EqualFold(e.State, RollStateFailed):
+		return target == ErrRollFailed
+	case strings.EqualFold(e.State, RollStateStopped):
+		return target == ErrRollStopped
+	}
+	return false
+}
+
+// RollDone classifies a roll state. It returns true if the roll finished
+// successfully, a *RollError if it failed or was stopped, and false otherwise.
+func RollDone(resourceID, rollID, state string) (bool, error) {
+	switch {
+	case strings.EqualFold(state, RollStateFinished):
+		return true, nil
+	case strings.EqualFold(state, RollStateFailed),
+		strings.EqualFold(state, RollStateStopped):
+		return false, &RollError{
+			ResourceID: resourceID,
+			RollID:     rollID,
+			State:      state,
+		}
+	}
+	return false, nil
+}
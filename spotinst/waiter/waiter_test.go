@@ -0,0 +1,74 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoll(t *testing.T) {
+	var calls int
+	err := Poll(context.Background(), &Options{PollInterval: time.Millisecond},
+		func(ctx context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPollTimeout(t *testing.T) {
+	err := Poll(context.Background(), &Options{
+		PollInterval: time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	}, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+
+	assert.True(t, errors.Is(err, ErrTimeout))
+}
+
+func TestPollContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := Poll(ctx, &Options{PollInterval: time.Millisecond},
+		func(ctx context.Context) (bool, error) {
+			return false, nil
+		})
+
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestRollDone(t *testing.T) {
+	cases := map[string]struct {
+		Done     bool
+		Sentinel error
+	}{
+		"IN_PROGRESS": {},
+		"FINISHED":    {Done: true},
+		"finished":    {Done: true},
+		"FAILED":      {Sentinel: ErrRollFailed},
+		"STOPPED":     {Sentinel: ErrRollStopped},
+	}
+
+	for state, c := range cases {
+		t.Run(state, func(t *testing.T) {
+			done, err := RollDone("sig-1", "sbgd-1", state)
+			assert.Equal(t, c.Done, done)
+			if c.Sentinel == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var rollErr *RollError
+			assert.True(t, errors.As(err, &rollErr))
+			assert.Equal(t, state, rollErr.State)
+			assert.True(t, errors.Is(err, c.Sentinel))
+		})
+	}
+}